@@ -0,0 +1,12 @@
+resource "time_static" "last_run" {}
+
+# Changes made to the products-service entity in the last day, for a scheduled drift report
+data "cortex_audit_logs" "products_service" {
+  start_time  = timeadd(time_static.last_run.rfc3339, "-24h")
+  object_type = "CATALOG_ENTITY"
+  object_tag  = "products-service"
+}
+
+output "products_service_changed_by" {
+  value = distinct([for log in data.cortex_audit_logs.products_service.logs : log.actor_email])
+}
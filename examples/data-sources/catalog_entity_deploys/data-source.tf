@@ -0,0 +1,9 @@
+data "cortex_catalog_entity_deploys" "products-production" {
+  entity_tag  = "products-service"
+  environment = "production"
+  limit       = 10
+}
+
+output "last_production_sha" {
+  value = try(data.cortex_catalog_entity_deploys.products-production.deploys[0].sha, null)
+}
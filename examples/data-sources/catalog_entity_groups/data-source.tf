@@ -0,0 +1,7 @@
+data "cortex_catalog_entity_groups" "services" {
+  types = ["service"]
+}
+
+output "group_sizes" {
+  value = { for group in data.cortex_catalog_entity_groups.services.groups : group.name => group.entity_count }
+}
@@ -0,0 +1,8 @@
+data "cortex_custom_metric_values" "weekly_deploys" {
+  tag = "weekly-deploys"
+}
+
+# Services that haven't deployed in the last week
+output "services_without_deploys" {
+  value = [for v in data.cortex_custom_metric_values.weekly_deploys.values : v.entity_tag if v.value == 0]
+}
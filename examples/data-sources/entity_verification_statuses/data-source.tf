@@ -0,0 +1,9 @@
+# Find the entities whose owners haven't verified them this quarter
+data "cortex_entity_verification_statuses" "unverified" {
+  period_id = cortex_entity_verification_period.quarterly.id
+  status    = "UNVERIFIED"
+}
+
+output "unverified_entities" {
+  value = [for s in data.cortex_entity_verification_statuses.unverified.statuses : s.entity_tag]
+}
@@ -0,0 +1,3 @@
+data "cortex_initiative" "dora-q3" {
+  id = "en2da8159dbeefb974"
+}
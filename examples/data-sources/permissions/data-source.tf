@@ -0,0 +1,6 @@
+# Retrieve every permission that can be granted by a role
+data "cortex_permissions" "all" {}
+
+output "catalog_permissions" {
+  value = [for p in data.cortex_permissions.all.permissions : p.identifier if p.category == "Catalog"]
+}
@@ -0,0 +1,6 @@
+# Retrieve all scaffolder templates
+data "cortex_scaffolder_templates" "all" {}
+
+output "scaffolder_template_tags" {
+  value = [for t in data.cortex_scaffolder_templates.all.templates : t.tag]
+}
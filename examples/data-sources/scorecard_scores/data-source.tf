@@ -0,0 +1,12 @@
+data "cortex_scorecard_scores" "dora" {
+  scorecard_tag = "dora"
+  entity_tag    = "payments-api"
+}
+
+# Block changes to the service until it has reached at least Silver (rank 2).
+check "payments-api-dora-level" {
+  assert {
+    condition     = data.cortex_scorecard_scores.dora.entities["payments-api"].level_rank >= 2
+    error_message = "payments-api must reach at least Silver on the DORA scorecard."
+  }
+}
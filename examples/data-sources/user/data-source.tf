@@ -0,0 +1,16 @@
+# Fails the plan if the owner has left the workspace
+data "cortex_user" "owner" {
+  email = "owner@example.com"
+}
+
+resource "cortex_catalog_entity" "products_service" {
+  tag  = "products-service"
+  name = "Products Service"
+
+  owners = [
+    {
+      type  = "email"
+      email = data.cortex_user.owner.email
+    }
+  ]
+}
@@ -0,0 +1,18 @@
+# Retrieve every user with an example.com email
+data "cortex_users" "example" {
+  email = "@example.com"
+}
+
+locals {
+  owner_emails = ["owner@example.com", "on-call@example.com"]
+}
+
+# Fail the plan if any owner has left the workspace
+resource "terraform_data" "validate_owners" {
+  lifecycle {
+    precondition {
+      condition     = alltrue([for email in local.owner_emails : contains(data.cortex_users.example.emails, email)])
+      error_message = "Every owner email must belong to a Cortex user."
+    }
+  }
+}
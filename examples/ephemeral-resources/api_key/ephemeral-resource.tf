@@ -0,0 +1,9 @@
+ephemeral "cortex_api_key" "ci" {
+  id = cortex_api_key.ci.id
+}
+
+# The secret is only read at apply time, and is never written to plan or state files.
+provider "cortex" {
+  alias = "ci"
+  token = ephemeral.cortex_api_key.ci.secret
+}
@@ -0,0 +1,11 @@
+resource "time_rotating" "ci" {
+  rotation_days = 60
+}
+
+# A new key, valid for 90 days, is created (and the old one deleted) every 60 days.
+resource "cortex_api_key" "ci" {
+  name            = "ci-pipeline"
+  description     = "Used by CI to report deploys to Cortex"
+  roles           = ["READ_ONLY"]
+  expiration_date = timeadd(time_rotating.ci.rfc3339, "2160h")
+}
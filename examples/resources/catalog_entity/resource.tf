@@ -359,3 +359,10 @@ resource "cortex_catalog_entity" "products-service" {
     ]
   }
 }
+
+# Archive the entity instead of deleting it when it is removed from Terraform, keeping its history and scorecard data.
+resource "cortex_catalog_entity" "legacy-billing-service" {
+  tag               = "legacy-billing-service"
+  name              = "Legacy Billing Service"
+  deletion_behavior = "archive"
+}
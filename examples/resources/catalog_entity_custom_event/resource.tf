@@ -0,0 +1,14 @@
+variable "run_url" {
+  type = string
+}
+
+resource "cortex_catalog_entity_custom_event" "products-db-failover" {
+  entity_tag  = "products-service"
+  type        = "DATABASE_FAILOVER"
+  title       = "Failover replica promoted"
+  description = "Terraform promoted the us-east-1b replica to primary."
+  url         = var.run_url
+  custom_data = jsonencode({
+    "region" : "us-east-1",
+  })
+}
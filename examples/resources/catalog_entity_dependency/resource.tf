@@ -0,0 +1,16 @@
+resource "cortex_catalog_entity_dependency" "checkout-on-products" {
+  caller_tag  = "checkout-service"
+  callee_tag  = "products-service"
+  description = "Checkout reads product prices"
+}
+
+resource "cortex_catalog_entity_dependency" "checkout-on-products-lookup" {
+  caller_tag  = "checkout-service"
+  callee_tag  = "products-service"
+  method      = "GET"
+  path        = "/products/{id}"
+  description = "Looks up a single product"
+  metadata = jsonencode({
+    "tier" : "critical",
+  })
+}
@@ -0,0 +1,15 @@
+variable "git_sha" {
+  type = string
+}
+
+resource "cortex_catalog_entity_deploy" "products-production" {
+  entity_tag     = "products-service"
+  title          = "Deploy ${var.git_sha}"
+  sha            = var.git_sha
+  environment    = "production"
+  deployer_name  = "Terraform"
+  deployer_email = "platform@example.com"
+  custom_data = jsonencode({
+    "pipeline" : "infra",
+  })
+}
@@ -0,0 +1,8 @@
+data "cortex_catalog_entities" "payments" {
+  owners = ["payments-team"]
+}
+
+resource "cortex_catalog_entity_group_membership" "pci" {
+  group       = "pci-scope"
+  entity_tags = [for entity in data.cortex_catalog_entities.payments.entities : entity.tag]
+}
@@ -0,0 +1,24 @@
+resource "cortex_custom_metric" "weekly_deploys" {
+  tag         = "weekly-deploys"
+  name        = "Weekly Deploys"
+  description = "Number of deploys over the last week"
+  unit        = "deploys"
+
+  aggregation = {
+    function      = "COUNT"
+    source        = "DEPLOYS"
+    lookback_days = 7
+  }
+
+  filter = {
+    types = {
+      include = ["service"]
+    }
+  }
+}
+
+resource "cortex_custom_metric" "open_incidents" {
+  tag   = "open-incidents"
+  name  = "Open Incidents"
+  query = "oncall.incidents().length"
+}
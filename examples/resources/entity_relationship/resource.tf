@@ -0,0 +1,5 @@
+resource "cortex_entity_relationship" "products-runs-on-prod" {
+  relationship_type = cortex_relationship_type.runs-on.tag
+  source_tag        = "products-service"
+  destination_tag   = "prod-cluster"
+}
@@ -0,0 +1,33 @@
+resource "cortex_entity_type" "business_unit" {
+  type             = "business-unit"
+  name             = "Business Unit"
+  description      = "A business unit, which owns domains and services."
+  display_name     = "Business Units"
+  icon             = "building"
+  parent_type      = "domain"
+  can_own_entities = true
+  schema = jsonencode({
+    "type" : "object",
+    "required" : ["cost-center"],
+    "properties" : {
+      "cost-center" : { "type" : "string" }
+    }
+  })
+}
+
+# Migrate an existing cortex_resource_definition without recreating it in Cortex.
+moved {
+  from = cortex_resource_definition.squid_proxy
+  to   = cortex_entity_type.squid_proxy
+}
+
+resource "cortex_entity_type" "squid_proxy" {
+  type = "squid-proxy"
+  name = "Squid Proxy"
+  schema = jsonencode({
+    "type" : "object",
+    "properties" : {
+      "ip" : { "type" : "string" }
+    }
+  })
+}
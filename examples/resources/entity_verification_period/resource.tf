@@ -0,0 +1,15 @@
+resource "cortex_entity_verification_period" "quarterly" {
+  name        = "Quarterly service attestation"
+  description = "Confirm that your service's owners, on-call and links are up to date."
+  cadence     = "QUARTERLY"
+  start_date  = "2026-01-01"
+
+  filter = {
+    types = {
+      include = ["service"]
+    }
+    groups = {
+      exclude = ["deprecated"]
+    }
+  }
+}
@@ -0,0 +1,20 @@
+resource "cortex_initiative" "dora-q3" {
+  name          = "Q3 DORA Gold"
+  description   = "All production services reach Gold on DORA metrics"
+  scorecard_tag = cortex_scorecard.dora-metrics.tag
+  target_date   = "2026-09-30"
+  levels        = ["Gold"]
+
+  filter = {
+    types = {
+      include = ["service"]
+    }
+    query = "entity.tag() != null"
+  }
+
+  notifications = {
+    interval        = 1
+    interval_unit   = "WEEKS"
+    reply_to_emails = ["sre@example.com"]
+  }
+}
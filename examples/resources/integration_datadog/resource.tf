@@ -0,0 +1,20 @@
+variable "datadog_api_key" {
+  type      = string
+  sensitive = true
+}
+
+variable "datadog_app_key" {
+  type      = string
+  sensitive = true
+}
+
+resource "cortex_integration_datadog" "default" {
+  alias      = "datadog-eu"
+  is_default = true
+  region     = "EU1"
+  api_key_wo = var.datadog_api_key
+  app_key_wo = var.datadog_app_key
+
+  # Increment when rotating the keys, so that they're sent to Cortex.
+  secrets_wo_version = 1
+}
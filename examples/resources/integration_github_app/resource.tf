@@ -0,0 +1,16 @@
+variable "github_app_client_secret" {
+  type      = string
+  sensitive = true
+}
+
+resource "cortex_integration_github_app" "default" {
+  alias            = "github"
+  is_default       = true
+  app_id           = "123456"
+  client_id        = "Iv1.0123456789abcdef"
+  client_secret_wo = var.github_app_client_secret
+  private_key_wo   = file("${path.module}/github-app.private-key.pem")
+
+  # Increment when rotating the secrets, so that they're sent to Cortex.
+  secrets_wo_version = 1
+}
@@ -0,0 +1,13 @@
+variable "pagerduty_token" {
+  type      = string
+  sensitive = true
+}
+
+resource "cortex_integration_pagerduty" "default" {
+  alias      = "pagerduty"
+  is_default = true
+  token_wo   = var.pagerduty_token
+
+  # Increment when rotating the token, so that it's sent to Cortex.
+  secrets_wo_version = 1
+}
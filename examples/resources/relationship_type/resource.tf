@@ -0,0 +1,20 @@
+resource "cortex_relationship_type" "runs-on" {
+  tag               = "runs-on"
+  name              = "Runs on"
+  description       = "Services running on a cluster"
+  cardinality       = "MANY_TO_ONE"
+  source_types      = ["service"]
+  destination_types = ["cluster"]
+}
+
+resource "cortex_relationship_type" "owned-by-cost-center" {
+  tag               = "owned-by-cost-center"
+  name              = "Owned by cost center"
+  destination_types = ["cost-center"]
+  inheritances = [
+    {
+      tag  = "owners"
+      type = "FALLBACK"
+    }
+  ]
+}
@@ -0,0 +1,19 @@
+data "cortex_permissions" "all" {}
+
+locals {
+  deployer_permissions = ["VIEW_CATALOG", "EDIT_DEPLOYS"]
+}
+
+resource "cortex_role" "deployer" {
+  tag         = "deployer"
+  name        = "Deployer"
+  description = "Can view the catalog and record deploys"
+  permissions = local.deployer_permissions
+
+  lifecycle {
+    precondition {
+      condition     = alltrue([for p in local.deployer_permissions : contains(data.cortex_permissions.all.identifiers, p)])
+      error_message = "Every permission must be one of the identifiers listed by cortex_permissions."
+    }
+  }
+}
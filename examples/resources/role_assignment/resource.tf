@@ -0,0 +1,11 @@
+# Assign a role to every member of a team
+resource "cortex_role_assignment" "platform_deployers" {
+  role_tag = cortex_role.deployer.tag
+  team_tag = "platform"
+}
+
+# Assign a role to a single user
+resource "cortex_role_assignment" "release_manager" {
+  role_tag   = cortex_role.deployer.tag
+  user_email = "release-manager@example.com"
+}
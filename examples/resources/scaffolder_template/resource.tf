@@ -0,0 +1,33 @@
+resource "cortex_scaffolder_template" "go-service" {
+  tag            = "go-service"
+  name           = "Go service"
+  description    = "Golden path for Go HTTP services"
+  repository_url = "https://github.com/example/cookiecutter-go-service"
+  branch         = "main"
+
+  git_provider = {
+    type         = "GITHUB"
+    organization = "example"
+  }
+
+  inputs = [
+    {
+      name        = "service_name"
+      description = "Name of the new service"
+      required    = true
+    },
+    {
+      name          = "go_version"
+      default_value = "1.24"
+    },
+  ]
+
+  default_owners = [
+    {
+      type     = "GROUP"
+      name     = "platform-engineering"
+      provider = "CORTEX"
+    },
+  ]
+  default_groups = ["golden-path"]
+}
@@ -42,4 +42,8 @@ resource "cortex_scorecard" "dora-metrics" {
   evaluation = {
     window = 24
   }
+  evaluate_on_change = {
+    wait    = true
+    timeout = "15m"
+  }
 }
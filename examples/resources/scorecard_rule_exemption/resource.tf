@@ -0,0 +1,7 @@
+resource "cortex_scorecard_rule_exemption" "legacy-billing-oncall" {
+  scorecard_tag   = cortex_scorecard.dora-metrics.tag
+  entity_tag      = "legacy-billing"
+  rule_identifier = "deploys-frequency"
+  reason          = "Service is frozen ahead of its Q4 decommission"
+  days            = 90
+}
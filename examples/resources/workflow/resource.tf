@@ -0,0 +1,47 @@
+resource "cortex_workflow" "provision-database" {
+  tag          = "provision-database"
+  name         = "Provision a database"
+  description  = "Requests a new database for a service, after approval from the DBA team."
+  entity_types = ["service"]
+
+  actions = [
+    {
+      name             = "Approve"
+      slug             = "approve"
+      outgoing_actions = ["provision"]
+      manual_approval = {
+        message         = "Approve a new database for {{context.entity.name}}?"
+        approver_emails = ["dba@example.com"]
+      }
+    },
+    {
+      name             = "Provision"
+      slug             = "provision"
+      outgoing_actions = ["notify"]
+      http_request = {
+        method = "POST"
+        url    = "https://infra.example.com/databases"
+        headers = {
+          "Content-Type" = "application/json"
+        }
+        payload = jsonencode({
+          "service" : "{{context.entity.tag}}",
+        })
+      }
+    },
+    {
+      name = "Notify"
+      slug = "notify"
+      slack = {
+        channel = "#databases"
+        message = "Provisioned a database for {{context.entity.name}}"
+      }
+    },
+  ]
+}
+
+# Workflows using actions the typed attributes don't cover can be managed from a descriptor exported from Cortex.
+resource "cortex_workflow" "restart-service" {
+  tag        = "restart-service"
+  descriptor = file("${path.module}/workflows/restart-service.yaml")
+}
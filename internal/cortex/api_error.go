@@ -8,6 +8,7 @@ import (
 var (
 	ApiErrorNotFound     = errors.New("not found")
 	ApiErrorUnauthorized = errors.New("unauthorized")
+	ApiErrorForbidden    = errors.New("forbidden")
 )
 
 type ApiError struct {
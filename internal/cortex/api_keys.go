@@ -0,0 +1,166 @@
+package cortex
+
+import (
+	"context"
+	"errors"
+	"github.com/dghubble/sling"
+)
+
+type ApiKeysClientInterface interface {
+	Get(ctx context.Context, id string) (ApiKey, error)
+	GetSecret(ctx context.Context, id string) (ApiKeySecret, error)
+	Create(ctx context.Context, req UpsertApiKeyRequest) (ApiKey, error)
+	Update(ctx context.Context, id string, req UpsertApiKeyRequest) (ApiKey, error)
+	Delete(ctx context.Context, id string) error
+}
+
+type ApiKeysClient struct {
+	client *HttpClient
+}
+
+var _ ApiKeysClientInterface = &ApiKeysClient{}
+
+func (c *ApiKeysClient) Client() *sling.Sling {
+	return c.client.Client()
+}
+
+/***********************************************************************************************************************
+ * Types
+ **********************************************************************************************************************/
+
+// ApiKey is a Cortex API key. Roles are the tags of the roles the key is granted, either built-in roles such as
+// "READ_ONLY" or custom roles. ExpirationDate is an RFC 3339 timestamp; keys without one never expire. The key's
+// secret is never part of ApiKey, and is only returned by GetSecret.
+type ApiKey struct {
+	Id             string   `json:"id,omitempty"`
+	Name           string   `json:"name"`
+	Description    string   `json:"description,omitempty"`
+	Roles          []string `json:"roles"`
+	ExpirationDate string   `json:"expirationDate,omitempty"`
+	CreatedDate    string   `json:"createdDate,omitempty"`
+	LastUsedDate   string   `json:"lastUsedDate,omitempty"`
+}
+
+// ApiKeySecret is the secret of an API key, used as the bearer token of API requests.
+type ApiKeySecret struct {
+	Id     string `json:"id"`
+	Secret string `json:"secret"`
+}
+
+/***********************************************************************************************************************
+ * GET /api/v1/api-keys/:id
+ **********************************************************************************************************************/
+
+func (c *ApiKeysClient) Get(ctx context.Context, id string) (ApiKey, error) {
+	apiKey := ApiKey{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Get(Route("api_keys", id)), &apiKey, &apiError)
+	if err != nil {
+		return apiKey, errors.New("could not get API key: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return apiKey, err
+	}
+
+	return apiKey, nil
+}
+
+/***********************************************************************************************************************
+ * GET /api/v1/api-keys/:id/secret
+ **********************************************************************************************************************/
+
+func (c *ApiKeysClient) GetSecret(ctx context.Context, id string) (ApiKeySecret, error) {
+	secret := ApiKeySecret{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Get(Route("api_keys", id+"/secret")), &secret, &apiError)
+	if err != nil {
+		return secret, errors.New("could not get API key secret: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return secret, err
+	}
+
+	return secret, nil
+}
+
+/***********************************************************************************************************************
+ * POST /api/v1/api-keys
+ **********************************************************************************************************************/
+
+type UpsertApiKeyRequest struct {
+	Name           string   `json:"name"`
+	Description    string   `json:"description,omitempty"`
+	Roles          []string `json:"roles"`
+	ExpirationDate string   `json:"expirationDate,omitempty"`
+}
+
+func (r *ApiKey) ToUpsertRequest() UpsertApiKeyRequest {
+	return UpsertApiKeyRequest{
+		Name:           r.Name,
+		Description:    r.Description,
+		Roles:          r.Roles,
+		ExpirationDate: r.ExpirationDate,
+	}
+}
+
+func (c *ApiKeysClient) Create(ctx context.Context, req UpsertApiKeyRequest) (ApiKey, error) {
+	apiKey := ApiKey{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Post(Route("api_keys", "")).BodyJSON(&req), &apiKey, &apiError)
+	if err != nil {
+		return apiKey, errors.New("could not create API key: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return apiKey, err
+	}
+
+	return apiKey, nil
+}
+
+/***********************************************************************************************************************
+ * PUT /api/v1/api-keys/:id
+ **********************************************************************************************************************/
+
+func (c *ApiKeysClient) Update(ctx context.Context, id string, req UpsertApiKeyRequest) (ApiKey, error) {
+	apiKey := ApiKey{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Put(Route("api_keys", id)).BodyJSON(&req), &apiKey, &apiError)
+	if err != nil {
+		return apiKey, errors.New("could not update API key: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return apiKey, err
+	}
+
+	return apiKey, nil
+}
+
+/***********************************************************************************************************************
+ * DELETE /api/v1/api-keys/:id
+ **********************************************************************************************************************/
+
+type DeleteApiKeyResponse struct{}
+
+func (c *ApiKeysClient) Delete(ctx context.Context, id string) error {
+	deleteResponse := DeleteApiKeyResponse{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Delete(Route("api_keys", id)), &deleteResponse, &apiError)
+	if err != nil {
+		return errors.New("could not delete API key: " + err.Error())
+	}
+
+	return c.client.handleResponseStatus(response, &apiError)
+}
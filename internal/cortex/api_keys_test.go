@@ -0,0 +1,85 @@
+package cortex_test
+
+import (
+	"context"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var testApiKey = cortex.ApiKey{
+	Id:             "ak-1",
+	Name:           "ci-pipeline",
+	Description:    "Used by CI to report deploys",
+	Roles:          []string{"READ_ONLY", "deployer"},
+	ExpirationDate: "2027-01-01T00:00:00Z",
+	CreatedDate:    "2026-10-01T00:00:00Z",
+}
+
+func TestGetApiKey(t *testing.T) {
+	c, teardown, err := setupClient(cortex.Route("api_keys", testApiKey.Id), testApiKey, AssertRequestMethod(t, "GET"))
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.ApiKeys().Get(context.Background(), testApiKey.Id)
+	assert.Nil(t, err, "error retrieving an API key")
+	assert.Equal(t, testApiKey, res)
+}
+
+func TestGetApiKeySecret(t *testing.T) {
+	secret := cortex.ApiKeySecret{Id: testApiKey.Id, Secret: "ctx-secret"}
+	c, teardown, err := setupClient(cortex.Route("api_keys", testApiKey.Id+"/secret"), secret, AssertRequestMethod(t, "GET"))
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.ApiKeys().GetSecret(context.Background(), testApiKey.Id)
+	assert.Nil(t, err, "error retrieving an API key secret")
+	assert.Equal(t, secret, res)
+}
+
+func TestCreateApiKey(t *testing.T) {
+	req := testApiKey.ToUpsertRequest()
+	c, teardown, err := setupClient(
+		cortex.Route("api_keys", ""),
+		testApiKey,
+		AssertRequestMethod(t, "POST"),
+		AssertRequestBody(t, req),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.ApiKeys().Create(context.Background(), req)
+	assert.Nil(t, err, "error creating an API key")
+	assert.Equal(t, testApiKey, res)
+}
+
+func TestUpdateApiKey(t *testing.T) {
+	updated := testApiKey
+	updated.Roles = []string{"READ_ONLY"}
+	req := updated.ToUpsertRequest()
+	c, teardown, err := setupClient(
+		cortex.Route("api_keys", testApiKey.Id),
+		updated,
+		AssertRequestMethod(t, "PUT"),
+		AssertRequestBody(t, req),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.ApiKeys().Update(context.Background(), testApiKey.Id, req)
+	assert.Nil(t, err, "error updating an API key")
+	assert.Equal(t, updated, res)
+}
+
+func TestDeleteApiKey(t *testing.T) {
+	c, teardown, err := setupClient(
+		cortex.Route("api_keys", testApiKey.Id),
+		cortex.DeleteApiKeyResponse{},
+		AssertRequestMethod(t, "DELETE"),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	err = c.ApiKeys().Delete(context.Background(), testApiKey.Id)
+	assert.Nil(t, err, "error deleting an API key")
+}
@@ -0,0 +1,104 @@
+package cortex
+
+import (
+	"context"
+	"errors"
+	"github.com/dghubble/sling"
+)
+
+type AuditLogsClientInterface interface {
+	List(ctx context.Context, params *AuditLogListParams) (*AuditLogsResponse, error)
+	ListAll(ctx context.Context, params *AuditLogListParams) ([]AuditLog, error)
+}
+
+type AuditLogsClient struct {
+	client *HttpClient
+}
+
+var _ AuditLogsClientInterface = &AuditLogsClient{}
+
+func (c *AuditLogsClient) Client() *sling.Sling {
+	return c.client.Client()
+}
+
+/***********************************************************************************************************************
+ * Types
+ **********************************************************************************************************************/
+
+const (
+	AuditLogActionCreate = "CREATE"
+	AuditLogActionUpdate = "UPDATE"
+	AuditLogActionDelete = "DELETE"
+)
+
+// AuditLog is a single change made in Cortex, by a user in the UI or with an API key. Timestamp is an RFC 3339
+// timestamp. ActorName is empty for changes made with an API key.
+type AuditLog struct {
+	Timestamp  string `json:"timestamp"`
+	Action     string `json:"action"`
+	ActorEmail string `json:"actorEmail,omitempty"`
+	ActorName  string `json:"actorName,omitempty"`
+	ObjectType string `json:"objectType"`
+	ObjectTag  string `json:"objectTag,omitempty"`
+}
+
+/***********************************************************************************************************************
+ * GET /api/v1/audit-logs
+ **********************************************************************************************************************/
+
+// AuditLogListParams are the query parameters for the GET /v1/audit-logs endpoint. StartTime and EndTime are RFC 3339
+// timestamps.
+type AuditLogListParams struct {
+	StartTime  string `url:"startTime,omitempty"`
+	EndTime    string `url:"endTime,omitempty"`
+	ActorEmail string `url:"actorEmail,omitempty"`
+	ObjectType string `url:"objectType,omitempty"`
+	ObjectTag  string `url:"objectTag,omitempty"`
+	Page       int    `url:"page,omitempty"`
+	PageSize   int    `url:"pageSize,omitempty"`
+}
+
+// AuditLogsResponse is a page of audit logs, most recent first.
+type AuditLogsResponse struct {
+	Logs       []AuditLog `json:"logs"`
+	Page       int        `json:"page"`
+	TotalPages int        `json:"totalPages"`
+	Total      int        `json:"total"`
+}
+
+func (c *AuditLogsClient) List(ctx context.Context, params *AuditLogListParams) (*AuditLogsResponse, error) {
+	logsResponse := &AuditLogsResponse{}
+	apiError := &ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Get(Route("audit_logs", "")).QueryStruct(params), logsResponse, apiError)
+	if err != nil {
+		return nil, errors.New("could not get audit logs: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, apiError)
+	if err != nil {
+		return nil, err
+	}
+
+	return logsResponse, nil
+}
+
+// ListAll returns every audit log matching the query, most recent first, fetching every page. Page and PageSize in
+// params are ignored.
+func (c *AuditLogsClient) ListAll(ctx context.Context, params *AuditLogListParams) ([]AuditLog, error) {
+	logs := []AuditLog{}
+	pageParams := *params
+	pageParams.PageSize = 250
+	pageParams.Page = 0
+	for {
+		logsResponse, err := c.List(ctx, &pageParams)
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, logsResponse.Logs...)
+		if logsResponse.Page >= logsResponse.TotalPages-1 || len(logsResponse.Logs) == 0 {
+			return logs, nil
+		}
+		pageParams.Page++
+	}
+}
@@ -0,0 +1,102 @@
+package cortex_test
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+func TestListAuditLogs(t *testing.T) {
+	log := cortex.AuditLog{
+		Timestamp:  "2026-10-01T12:00:00Z",
+		Action:     cortex.AuditLogActionUpdate,
+		ActorEmail: "someone@example.com",
+		ActorName:  "Someone",
+		ObjectType: "SCORECARD",
+		ObjectTag:  "production-readiness",
+	}
+	c, teardown, err := setupClient(
+		cortex.Route("audit_logs", ""),
+		cortex.AuditLogsResponse{Logs: []cortex.AuditLog{log}, Page: 0, TotalPages: 1, Total: 1},
+		AssertRequestMethod(t, "GET"),
+		AssertRequestURI(t, cortex.Route("audit_logs", "")+"?objectType=SCORECARD&pageSize=10&startTime=2026-10-01T00%3A00%3A00Z"),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.AuditLogs().List(context.Background(), &cortex.AuditLogListParams{
+		StartTime:  "2026-10-01T00:00:00Z",
+		ObjectType: "SCORECARD",
+		PageSize:   10,
+	})
+	assert.Nil(t, err, "error listing audit logs")
+	assert.Equal(t, []cortex.AuditLog{log}, res.Logs)
+}
+
+func TestListAllAuditLogs(t *testing.T) {
+	tests := []struct {
+		name       string
+		pages      [][]cortex.AuditLog
+		totalPages int
+		expected   []string
+		requested  []string
+	}{
+		{
+			name: "multiple pages",
+			pages: [][]cortex.AuditLog{
+				{{Timestamp: "2026-10-03T00:00:00Z"}, {Timestamp: "2026-10-02T00:00:00Z"}},
+				{{Timestamp: "2026-10-01T00:00:00Z"}},
+			},
+			totalPages: 2,
+			expected:   []string{"2026-10-03T00:00:00Z", "2026-10-02T00:00:00Z", "2026-10-01T00:00:00Z"},
+			requested:  []string{"", "1"},
+		},
+		{
+			name: "empty page",
+			pages: [][]cortex.AuditLog{
+				{{Timestamp: "2026-10-03T00:00:00Z"}},
+				{},
+			},
+			totalPages: 5,
+			expected:   []string{"2026-10-03T00:00:00Z"},
+			requested:  []string{"", "1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requested := []string{}
+			mux := http.NewServeMux()
+			mux.HandleFunc(cortex.Route("audit_logs", ""), func(w http.ResponseWriter, req *http.Request) {
+				query := req.URL.Query()
+				assert.Equal(t, "someone@example.com", query.Get("actorEmail"))
+				assert.Equal(t, "250", query.Get("pageSize"))
+				requested = append(requested, query.Get("page"))
+
+				page, _ := strconv.Atoi(query.Get("page"))
+				_ = json.NewEncoder(w).Encode(cortex.AuditLogsResponse{
+					Logs:       tt.pages[page],
+					Page:       page,
+					TotalPages: tt.totalPages,
+				})
+			})
+			c, teardown, err := buildClient(mux)
+			assert.Nil(t, err, "could not setup client")
+			defer teardown()
+
+			params := &cortex.AuditLogListParams{ActorEmail: "someone@example.com"}
+			logs, err := c.AuditLogs().ListAll(context.Background(), params)
+			assert.Nil(t, err, "error listing every page of audit logs")
+			timestamps := []string{}
+			for _, log := range logs {
+				timestamps = append(timestamps, log.Timestamp)
+			}
+			assert.Equal(t, tt.expected, timestamps)
+			assert.Equal(t, tt.requested, requested)
+			assert.Equal(t, 0, params.Page, "the caller's params must not be modified")
+		})
+	}
+}
@@ -14,8 +14,11 @@ type CatalogEntitiesClientInterface interface {
 	Get(ctx context.Context, tag string) (*CatalogEntity, error)
 	GetFromDescriptor(ctx context.Context, tag string) (CatalogEntityData, error)
 	List(ctx context.Context, params *CatalogEntityListParams) (*CatalogEntitiesResponse, error)
+	ListAll(ctx context.Context, params *CatalogEntityListParams) ([]CatalogEntity, error)
 	Upsert(ctx context.Context, req UpsertCatalogEntityRequest) (CatalogEntityData, error)
 	Delete(ctx context.Context, tag string) error
+	Archive(ctx context.Context, tag string) error
+	Unarchive(ctx context.Context, tag string) error
 }
 
 type CatalogEntitiesClient struct {
@@ -145,7 +148,7 @@ func (v *CatalogEntityViolation) String() string {
 func (c *CatalogEntitiesClient) Get(ctx context.Context, tag string) (*CatalogEntity, error) {
 	catalogEntityResponse := &CatalogEntity{}
 	apiError := &ApiError{}
-	response, err := c.Client().Get(Route("catalog_entities", tag)).Receive(catalogEntityResponse, apiError)
+	response, err := c.client.receive(ctx, c.Client().Get(Route("catalog_entities", tag)), catalogEntityResponse, apiError)
 	if err != nil {
 		return catalogEntityResponse, errors.New("could not get catalog entity: " + err.Error())
 	}
@@ -171,7 +174,7 @@ func (c *CatalogEntitiesClient) GetFromDescriptor(ctx context.Context, tag strin
 	}
 	uri := Route("catalog_entities", tag+"/openapi")
 	cl := c.YamlClient().Get(uri).QueryStruct(params)
-	response, err := cl.Receive(entityDescriptorResponse, apiError)
+	response, err := c.client.receive(ctx, cl, entityDescriptorResponse, apiError)
 	if err != nil {
 		return CatalogEntityData{}, errors.Join(fmt.Errorf("failed getting catalog entity descriptor for %s from %s", tag, uri), err)
 	}
@@ -181,8 +184,6 @@ func (c *CatalogEntitiesClient) GetFromDescriptor(ctx context.Context, tag strin
 		return CatalogEntityData{}, errors.Join(fmt.Errorf("failed handling response status for %s from %s", tag, uri), err)
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("body: %+v", entityDescriptorResponse))
-
 	return c.parser.YamlToEntity(entityDescriptorResponse)
 }
 
@@ -216,7 +217,7 @@ func (c *CatalogEntitiesClient) List(ctx context.Context, params *CatalogEntityL
 	entitiesResponse := &CatalogEntitiesResponse{}
 	apiError := &ApiError{}
 
-	response, err := c.Client().Get(Route("catalog_entities", "")).QueryStruct(&params).Receive(entitiesResponse, apiError)
+	response, err := c.client.receive(ctx, c.Client().Get(Route("catalog_entities", "")).QueryStruct(&params), entitiesResponse, apiError)
 	if err != nil {
 		return nil, errors.New("could not get entities: " + err.Error())
 	}
@@ -229,6 +230,25 @@ func (c *CatalogEntitiesClient) List(ctx context.Context, params *CatalogEntityL
 	return entitiesResponse, nil
 }
 
+// ListAll retrieves every entity matching the query, fetching every page. Page and PageSize in params are ignored.
+func (c *CatalogEntitiesClient) ListAll(ctx context.Context, params *CatalogEntityListParams) ([]CatalogEntity, error) {
+	entities := []CatalogEntity{}
+	pageParams := *params
+	pageParams.PageSize = 250
+	pageParams.Page = 0
+	for {
+		entitiesResponse, err := c.List(ctx, &pageParams)
+		if err != nil {
+			return nil, err
+		}
+		entities = append(entities, entitiesResponse.Entities...)
+		if entitiesResponse.Page >= entitiesResponse.TotalPages-1 || len(entitiesResponse.Entities) == 0 {
+			return entities, nil
+		}
+		pageParams.Page++
+	}
+}
+
 /***********************************************************************************************************************
  * POST /api/v1/open-api
  **********************************************************************************************************************/
@@ -261,20 +281,25 @@ func (c *CatalogEntitiesClient) Upsert(ctx context.Context, req UpsertCatalogEnt
 	}
 	body := strings.NewReader(string(bytes))
 
-	tflog.Info(ctx, fmt.Sprintf("CREATE body: %+v", body))
-	response, err := c.Client().
+	logCtx := withRequestLog(ctx, "Upserting catalog entity descriptor", map[string]interface{}{
+		"body": redactYaml(string(bytes)),
+	})
+	cl := c.Client().
 		Set("Content-Type", "application/openapi;charset=UTF-8").
 		Post(Route("open_api", "")).
-		Body(body).
-		Receive(upsertResponse, apiError)
+		Body(body)
+	response, err := c.client.receive(logCtx, cl, upsertResponse, apiError)
 	if err != nil {
 		return CatalogEntityData{}, errors.New("could not upsert catalog entity: " + err.Error())
 	}
 
 	err = c.client.handleResponseStatus(response, apiError)
 	if err != nil {
-		reqYaml, _ := yaml.Marshal(req)
-		tflog.Error(ctx, fmt.Sprintf("Failed upserting catalog entity: %+v\n\nRequest:\n%+v\n%+v", err, string(reqYaml), apiError.String()))
+		tflog.SubsystemError(responseLogContext(ctx, response), LogSubsystem, "Failed upserting catalog entity", map[string]interface{}{
+			"error":     err.Error(),
+			"body":      redactYaml(string(bytes)),
+			"api_error": apiError.String(),
+		})
 		return CatalogEntityData{}, err
 	}
 
@@ -298,7 +323,7 @@ func (c *CatalogEntitiesClient) Upsert(ctx context.Context, req UpsertCatalogEnt
 func (c *CatalogEntitiesClient) Delete(ctx context.Context, tag string) error {
 	apiError := &ApiError{}
 
-	response, err := c.Client().Delete(Route("catalog_entities", tag)).Receive(nil, apiError)
+	response, err := c.client.receive(ctx, c.Client().Delete(Route("catalog_entities", tag)), nil, apiError)
 	if err != nil {
 		return errors.New("could not delete catalog entity: " + err.Error())
 	}
@@ -310,3 +335,49 @@ func (c *CatalogEntitiesClient) Delete(ctx context.Context, tag string) error {
 
 	return nil
 }
+
+/***********************************************************************************************************************
+ * PUT /api/v1/catalog/:tag/archive - Archive a catalog entity
+ **********************************************************************************************************************/
+
+type ArchiveCatalogEntityResponse struct{}
+
+func (c *CatalogEntitiesClient) Archive(ctx context.Context, tag string) error {
+	archiveResponse := &ArchiveCatalogEntityResponse{}
+	apiError := &ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Put(Route("catalog_entities", tag+"/archive")), archiveResponse, apiError)
+	if err != nil {
+		return errors.New("could not archive catalog entity: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, apiError)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+/***********************************************************************************************************************
+ * PUT /api/v1/catalog/:tag/unarchive - Un-archive a catalog entity
+ **********************************************************************************************************************/
+
+type UnarchiveCatalogEntityResponse struct{}
+
+func (c *CatalogEntitiesClient) Unarchive(ctx context.Context, tag string) error {
+	unarchiveResponse := &UnarchiveCatalogEntityResponse{}
+	apiError := &ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Put(Route("catalog_entities", tag+"/unarchive")), unarchiveResponse, apiError)
+	if err != nil {
+		return errors.New("could not unarchive catalog entity: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, apiError)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -2,8 +2,11 @@ package cortex_test
 
 import (
 	"context"
+	"encoding/json"
 	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
 	"github.com/stretchr/testify/assert"
+	"net/http"
+	"strconv"
 	"testing"
 )
 
@@ -40,6 +43,70 @@ func TestListCatalogEntities(t *testing.T) {
 	assert.Equal(t, res.Entities[0].Tag, firstTag)
 }
 
+func TestListAllCatalogEntities(t *testing.T) {
+	tests := []struct {
+		name       string
+		pages      [][]cortex.CatalogEntity
+		totalPages int
+		expected   []string
+		requested  []string
+	}{
+		{
+			name: "multiple pages",
+			pages: [][]cortex.CatalogEntity{
+				{{Tag: "service-1"}, {Tag: "service-2"}},
+				{{Tag: "service-3"}},
+			},
+			totalPages: 2,
+			expected:   []string{"service-1", "service-2", "service-3"},
+			requested:  []string{"", "1"},
+		},
+		{
+			name: "empty page",
+			pages: [][]cortex.CatalogEntity{
+				{{Tag: "service-1"}},
+				{},
+			},
+			totalPages: 5,
+			expected:   []string{"service-1"},
+			requested:  []string{"", "1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requested := []string{}
+			mux := http.NewServeMux()
+			mux.HandleFunc(cortex.Route("catalog_entities", ""), func(w http.ResponseWriter, req *http.Request) {
+				query := req.URL.Query()
+				assert.Equal(t, "production", query.Get("groups"))
+				assert.Equal(t, "250", query.Get("pageSize"))
+				requested = append(requested, query.Get("page"))
+
+				page, _ := strconv.Atoi(query.Get("page"))
+				_ = json.NewEncoder(w).Encode(cortex.CatalogEntitiesResponse{
+					Entities:   tt.pages[page],
+					Page:       page,
+					TotalPages: tt.totalPages,
+				})
+			})
+			c, teardown, err := buildClient(mux)
+			assert.Nil(t, err, "could not setup client")
+			defer teardown()
+
+			params := &cortex.CatalogEntityListParams{Groups: []string{"production"}}
+			entities, err := c.CatalogEntities().ListAll(context.Background(), params)
+			assert.Nil(t, err, "error retrieving every page of entities")
+			tags := []string{}
+			for _, entity := range entities {
+				tags = append(tags, entity.Tag)
+			}
+			assert.Equal(t, tt.expected, tags)
+			assert.Equal(t, tt.requested, requested)
+			assert.Equal(t, 0, params.Page, "the caller's params must not be modified")
+		})
+	}
+}
+
 func TestListCatalogEntitiesWithOwners(t *testing.T) {
 	resp := &cortex.CatalogEntitiesResponse{
 		Entities: []cortex.CatalogEntity{
@@ -113,3 +180,29 @@ func TestListCatalogEntitiesDeserializesOwnersAndGit(t *testing.T) {
 	assert.Equal(t, "my-org/my-repo", got.Git.Repository)
 	assert.Equal(t, "https://github.com/my-org/my-repo", got.Git.RepositoryUrl)
 }
+
+func TestArchiveCatalogEntity(t *testing.T) {
+	c, teardown, err := setupClient(
+		cortex.Route("catalog_entities", testCatalogEntity.Tag+"/archive"),
+		cortex.ArchiveCatalogEntityResponse{},
+		AssertRequestMethod(t, "PUT"),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	err = c.CatalogEntities().Archive(context.Background(), testCatalogEntity.Tag)
+	assert.Nil(t, err, "error archiving a catalog entity")
+}
+
+func TestUnarchiveCatalogEntity(t *testing.T) {
+	c, teardown, err := setupClient(
+		cortex.Route("catalog_entities", testCatalogEntity.Tag+"/unarchive"),
+		cortex.UnarchiveCatalogEntityResponse{},
+		AssertRequestMethod(t, "PUT"),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	err = c.CatalogEntities().Unarchive(context.Background(), testCatalogEntity.Tag)
+	assert.Nil(t, err, "error unarchiving a catalog entity")
+}
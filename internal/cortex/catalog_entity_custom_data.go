@@ -60,7 +60,7 @@ func (c *CatalogEntityCustomData) ValueAsString() (string, error) {
 func (c *CatalogEntityCustomDataClient) Get(ctx context.Context, entityTag string, key string) (CatalogEntityCustomData, error) {
 	entity := CatalogEntityCustomData{}
 	apiError := ApiError{}
-	response, err := c.Client().Get(Route("catalog_entities", entityTag+"/custom-data/"+key)).Receive(&entity, &apiError)
+	response, err := c.client.receive(ctx, c.Client().Get(Route("catalog_entities", entityTag+"/custom-data/"+key)), &entity, &apiError)
 	if err != nil {
 		return entity, errors.New("could not get catalog entity custom data: " + err.Error())
 	}
@@ -86,7 +86,7 @@ func (c *CatalogEntityCustomDataClient) List(ctx context.Context, entityTag stri
 	var entities []CatalogEntityCustomData
 	apiError := ApiError{}
 
-	response, err := c.Client().Get(Route("catalog_entities", entityTag+"/custom-data")).QueryStruct(&params).Receive(entities, &apiError)
+	response, err := c.client.receive(ctx, c.Client().Get(Route("catalog_entities", entityTag+"/custom-data")).QueryStruct(&params), entities, &apiError)
 	if err != nil {
 		return nil, errors.New("could not get catalog entity custom data: " + err.Error())
 	}
@@ -128,7 +128,7 @@ func (c *CatalogEntityCustomDataClient) Upsert(ctx context.Context, entityTag st
 
 	req.Force = true
 
-	body, err := c.Client().Post(Route("catalog_entities", entityTag+"/custom-data")).BodyJSON(&req).Receive(&entity, &apiError)
+	body, err := c.client.receive(ctx, c.Client().Post(Route("catalog_entities", entityTag+"/custom-data")).BodyJSON(&req), &entity, &apiError)
 	if err != nil {
 		return entity, fmt.Errorf("failed upserting custom data for entity: %+v", err)
 	}
@@ -160,7 +160,7 @@ func (c *CatalogEntityCustomDataClient) Delete(ctx context.Context, entityTag st
 		Force: true,
 	}
 
-	body, err := c.Client().Delete(Route("catalog_entities", entityTag+"/custom-data")).QueryStruct(&params).Receive(&response, &apiError)
+	body, err := c.client.receive(ctx, c.Client().Delete(Route("catalog_entities", entityTag+"/custom-data")).QueryStruct(&params), &response, &apiError)
 	if err != nil {
 		return errors.New("could not delete custom data for catalog entity: " + err.Error())
 	}
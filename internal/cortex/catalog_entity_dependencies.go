@@ -0,0 +1,161 @@
+package cortex
+
+import (
+	"context"
+	"errors"
+	"github.com/dghubble/sling"
+)
+
+type CatalogEntityDependenciesClientInterface interface {
+	Get(ctx context.Context, callerTag string, calleeTag string, params CatalogEntityDependencyParams) (CatalogEntityDependencyEdge, error)
+	Create(ctx context.Context, callerTag string, calleeTag string, params CatalogEntityDependencyParams, req UpsertCatalogEntityDependencyRequest) (CatalogEntityDependencyEdge, error)
+	Update(ctx context.Context, callerTag string, calleeTag string, params CatalogEntityDependencyParams, req UpsertCatalogEntityDependencyRequest) (CatalogEntityDependencyEdge, error)
+	Delete(ctx context.Context, callerTag string, calleeTag string, params CatalogEntityDependencyParams) error
+}
+
+type CatalogEntityDependenciesClient struct {
+	client *HttpClient
+}
+
+var _ CatalogEntityDependenciesClientInterface = &CatalogEntityDependenciesClient{}
+
+func (c *CatalogEntityDependenciesClient) Client() *sling.Sling {
+	return c.client.Client()
+}
+
+/***********************************************************************************************************************
+ * Types
+ **********************************************************************************************************************/
+
+// CatalogEntityDependencyEdge is a dependency from a caller entity on a callee entity, optionally scoped to a single
+// endpoint of the callee.
+type CatalogEntityDependencyEdge struct {
+	CallerTag   string                 `json:"callerTag"`
+	CalleeTag   string                 `json:"calleeTag"`
+	Method      string                 `json:"method,omitempty"`
+	Path        string                 `json:"path,omitempty"`
+	Description string                 `json:"description,omitempty"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// ID identifies the dependency as callerTag:calleeTag, followed by :method:path for endpoint dependencies. The path
+// comes last since it may itself contain colons.
+func (e *CatalogEntityDependencyEdge) ID() string {
+	id := e.CallerTag + ":" + e.CalleeTag
+	if e.Method != "" || e.Path != "" {
+		id += ":" + e.Method + ":" + e.Path
+	}
+	return id
+}
+
+// CatalogEntityDependencyParams are the query parameters identifying an endpoint dependency. Both are empty for a
+// dependency on the entity as a whole.
+type CatalogEntityDependencyParams struct {
+	Method string `url:"method,omitempty"`
+	Path   string `url:"path,omitempty"`
+}
+
+// identify fills in the identifying fields of a dependency from the request, as responses do not always echo them.
+func (e *CatalogEntityDependencyEdge) identify(callerTag string, calleeTag string, params CatalogEntityDependencyParams) {
+	e.CallerTag = callerTag
+	e.CalleeTag = calleeTag
+	if e.Method == "" {
+		e.Method = params.Method
+	}
+	if e.Path == "" {
+		e.Path = params.Path
+	}
+}
+
+func (c *CatalogEntityDependenciesClient) route(callerTag string, calleeTag string) string {
+	return Route("catalog_entities", callerTag+"/dependencies/"+calleeTag)
+}
+
+/***********************************************************************************************************************
+ * GET /api/v1/catalog/:callerTag/dependencies/:calleeTag
+ **********************************************************************************************************************/
+
+func (c *CatalogEntityDependenciesClient) Get(ctx context.Context, callerTag string, calleeTag string, params CatalogEntityDependencyParams) (CatalogEntityDependencyEdge, error) {
+	dependency := CatalogEntityDependencyEdge{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Get(c.route(callerTag, calleeTag)).QueryStruct(&params), &dependency, &apiError)
+	if err != nil {
+		return dependency, errors.New("could not get catalog entity dependency: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return dependency, err
+	}
+
+	dependency.identify(callerTag, calleeTag, params)
+	return dependency, nil
+}
+
+/***********************************************************************************************************************
+ * POST /api/v1/catalog/:callerTag/dependencies/:calleeTag
+ **********************************************************************************************************************/
+
+type UpsertCatalogEntityDependencyRequest struct {
+	Description string                 `json:"description,omitempty"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+}
+
+func (c *CatalogEntityDependenciesClient) Create(ctx context.Context, callerTag string, calleeTag string, params CatalogEntityDependencyParams, req UpsertCatalogEntityDependencyRequest) (CatalogEntityDependencyEdge, error) {
+	dependency := CatalogEntityDependencyEdge{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Post(c.route(callerTag, calleeTag)).QueryStruct(&params).BodyJSON(&req), &dependency, &apiError)
+	if err != nil {
+		return dependency, errors.New("could not create catalog entity dependency: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return dependency, err
+	}
+
+	dependency.identify(callerTag, calleeTag, params)
+	return dependency, nil
+}
+
+/***********************************************************************************************************************
+ * PUT /api/v1/catalog/:callerTag/dependencies/:calleeTag
+ **********************************************************************************************************************/
+
+func (c *CatalogEntityDependenciesClient) Update(ctx context.Context, callerTag string, calleeTag string, params CatalogEntityDependencyParams, req UpsertCatalogEntityDependencyRequest) (CatalogEntityDependencyEdge, error) {
+	dependency := CatalogEntityDependencyEdge{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Put(c.route(callerTag, calleeTag)).QueryStruct(&params).BodyJSON(&req), &dependency, &apiError)
+	if err != nil {
+		return dependency, errors.New("could not update catalog entity dependency: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return dependency, err
+	}
+
+	dependency.identify(callerTag, calleeTag, params)
+	return dependency, nil
+}
+
+/***********************************************************************************************************************
+ * DELETE /api/v1/catalog/:callerTag/dependencies/:calleeTag
+ **********************************************************************************************************************/
+
+type DeleteCatalogEntityDependencyResponse struct{}
+
+func (c *CatalogEntityDependenciesClient) Delete(ctx context.Context, callerTag string, calleeTag string, params CatalogEntityDependencyParams) error {
+	deleteResponse := DeleteCatalogEntityDependencyResponse{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Delete(c.route(callerTag, calleeTag)).QueryStruct(&params), &deleteResponse, &apiError)
+	if err != nil {
+		return errors.New("could not delete catalog entity dependency: " + err.Error())
+	}
+
+	return c.client.handleResponseStatus(response, &apiError)
+}
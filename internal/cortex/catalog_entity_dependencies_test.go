@@ -0,0 +1,86 @@
+package cortex_test
+
+import (
+	"context"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var testCatalogEntityDependency = cortex.CatalogEntityDependencyEdge{
+	CallerTag:   "test-caller",
+	CalleeTag:   "test-callee",
+	Method:      "GET",
+	Path:        "/users/:id",
+	Description: "Looks up users",
+	Metadata:    map[string]interface{}{"tier": "critical"},
+}
+
+func TestGetCatalogEntityDependency(t *testing.T) {
+	c, teardown, err := setupClient(
+		cortex.Route("catalog_entities", "test-caller/dependencies/test-callee"),
+		testCatalogEntityDependency,
+		AssertRequestMethod(t, "GET"),
+		AssertRequestURI(t, cortex.Route("catalog_entities", "test-caller/dependencies/test-callee")+"?method=GET&path=%2Fusers%2F%3Aid"),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.CatalogEntityDependencies().Get(context.Background(), "test-caller", "test-callee", cortex.CatalogEntityDependencyParams{Method: "GET", Path: "/users/:id"})
+	assert.Nil(t, err, "error retrieving a catalog entity dependency")
+	assert.Equal(t, testCatalogEntityDependency, res)
+	assert.Equal(t, "test-caller:test-callee:GET:/users/:id", res.ID())
+}
+
+func TestCreateCatalogEntityDependency(t *testing.T) {
+	req := cortex.UpsertCatalogEntityDependencyRequest{
+		Description: testCatalogEntityDependency.Description,
+		Metadata:    testCatalogEntityDependency.Metadata,
+	}
+	c, teardown, err := setupClient(
+		cortex.Route("catalog_entities", "test-caller/dependencies/test-callee"),
+		testCatalogEntityDependency,
+		AssertRequestMethod(t, "POST"),
+		AssertRequestBody(t, req),
+		AssertRequestURI(t, cortex.Route("catalog_entities", "test-caller/dependencies/test-callee")+"?method=GET&path=%2Fusers%2F%3Aid"),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.CatalogEntityDependencies().Create(context.Background(), "test-caller", "test-callee", cortex.CatalogEntityDependencyParams{Method: "GET", Path: "/users/:id"}, req)
+	assert.Nil(t, err, "error creating a catalog entity dependency")
+	assert.Equal(t, testCatalogEntityDependency, res)
+}
+
+func TestUpdateCatalogEntityDependency(t *testing.T) {
+	req := cortex.UpsertCatalogEntityDependencyRequest{Description: "Looks up users and teams"}
+	updated := testCatalogEntityDependency
+	updated.Description = req.Description
+	updated.Metadata = nil
+	c, teardown, err := setupClient(
+		cortex.Route("catalog_entities", "test-caller/dependencies/test-callee"),
+		updated,
+		AssertRequestMethod(t, "PUT"),
+		AssertRequestBody(t, req),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.CatalogEntityDependencies().Update(context.Background(), "test-caller", "test-callee", cortex.CatalogEntityDependencyParams{Method: "GET", Path: "/users/:id"}, req)
+	assert.Nil(t, err, "error updating a catalog entity dependency")
+	assert.Equal(t, updated, res)
+}
+
+func TestDeleteCatalogEntityDependency(t *testing.T) {
+	c, teardown, err := setupClient(
+		cortex.Route("catalog_entities", "test-caller/dependencies/test-callee"),
+		cortex.DeleteCatalogEntityDependencyResponse{},
+		AssertRequestMethod(t, "DELETE"),
+		AssertRequestURI(t, cortex.Route("catalog_entities", "test-caller/dependencies/test-callee")),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	err = c.CatalogEntityDependencies().Delete(context.Background(), "test-caller", "test-callee", cortex.CatalogEntityDependencyParams{})
+	assert.Nil(t, err, "error deleting a catalog entity dependency")
+}
@@ -0,0 +1,112 @@
+package cortex
+
+import (
+	"context"
+	"errors"
+	"github.com/dghubble/sling"
+)
+
+type CatalogEntityGroupsClientInterface interface {
+	Get(ctx context.Context, entityTag string) ([]string, error)
+	Add(ctx context.Context, entityTag string, groups []string) error
+	Remove(ctx context.Context, entityTag string, groups []string) error
+}
+
+type CatalogEntityGroupsClient struct {
+	client *HttpClient
+}
+
+var _ CatalogEntityGroupsClientInterface = &CatalogEntityGroupsClient{}
+
+func (c *CatalogEntityGroupsClient) Client() *sling.Sling {
+	return c.client.Client()
+}
+
+/***********************************************************************************************************************
+ * Types
+ **********************************************************************************************************************/
+
+// CatalogEntityGroupTag is a group an entity belongs to, as used by the entity groups endpoints.
+type CatalogEntityGroupTag struct {
+	Tag string `json:"tag"`
+}
+
+// CatalogEntityGroupsRequest is the body used to add or remove groups of an entity.
+type CatalogEntityGroupsRequest struct {
+	Groups []CatalogEntityGroupTag `json:"groups"`
+}
+
+type CatalogEntityGroupsResponse struct {
+	Groups []CatalogEntityGroupTag `json:"groups"`
+}
+
+func NewCatalogEntityGroupsRequest(groups []string) CatalogEntityGroupsRequest {
+	req := CatalogEntityGroupsRequest{Groups: make([]CatalogEntityGroupTag, len(groups))}
+	for i, group := range groups {
+		req.Groups[i] = CatalogEntityGroupTag{Tag: group}
+	}
+	return req
+}
+
+func (c *CatalogEntityGroupsClient) route(entityTag string) string {
+	return Route("catalog_entities", entityTag+"/groups")
+}
+
+/***********************************************************************************************************************
+ * GET /api/v1/catalog/:tag/groups
+ **********************************************************************************************************************/
+
+func (c *CatalogEntityGroupsClient) Get(ctx context.Context, entityTag string) ([]string, error) {
+	groupsResponse := CatalogEntityGroupsResponse{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Get(c.route(entityTag)), &groupsResponse, &apiError)
+	if err != nil {
+		return nil, errors.New("could not get catalog entity groups: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]string, len(groupsResponse.Groups))
+	for i, group := range groupsResponse.Groups {
+		groups[i] = group.Tag
+	}
+	return groups, nil
+}
+
+/***********************************************************************************************************************
+ * PUT /api/v1/catalog/:tag/groups
+ **********************************************************************************************************************/
+
+func (c *CatalogEntityGroupsClient) Add(ctx context.Context, entityTag string, groups []string) error {
+	groupsResponse := CatalogEntityGroupsResponse{}
+	apiError := ApiError{}
+	req := NewCatalogEntityGroupsRequest(groups)
+
+	response, err := c.client.receive(ctx, c.Client().Put(c.route(entityTag)).BodyJSON(&req), &groupsResponse, &apiError)
+	if err != nil {
+		return errors.New("could not add catalog entity groups: " + err.Error())
+	}
+
+	return c.client.handleResponseStatus(response, &apiError)
+}
+
+/***********************************************************************************************************************
+ * DELETE /api/v1/catalog/:tag/groups
+ **********************************************************************************************************************/
+
+func (c *CatalogEntityGroupsClient) Remove(ctx context.Context, entityTag string, groups []string) error {
+	groupsResponse := CatalogEntityGroupsResponse{}
+	apiError := ApiError{}
+	req := NewCatalogEntityGroupsRequest(groups)
+
+	response, err := c.client.receive(ctx, c.Client().Delete(c.route(entityTag)).BodyJSON(&req), &groupsResponse, &apiError)
+	if err != nil {
+		return errors.New("could not remove catalog entity groups: " + err.Error())
+	}
+
+	return c.client.handleResponseStatus(response, &apiError)
+}
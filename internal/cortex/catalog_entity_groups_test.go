@@ -0,0 +1,56 @@
+package cortex_test
+
+import (
+	"context"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestGetCatalogEntityGroups(t *testing.T) {
+	c, teardown, err := setupClient(
+		cortex.Route("catalog_entities", "test-service/groups"),
+		cortex.NewCatalogEntityGroupsRequest([]string{"payments", "tier-1"}),
+		AssertRequestMethod(t, "GET"),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.CatalogEntityGroups().Get(context.Background(), "test-service")
+	assert.Nil(t, err, "error retrieving catalog entity groups")
+	assert.Equal(t, []string{"payments", "tier-1"}, res)
+}
+
+func TestAddCatalogEntityGroups(t *testing.T) {
+	req := cortex.CatalogEntityGroupsRequest{
+		Groups: []cortex.CatalogEntityGroupTag{{Tag: "payments"}},
+	}
+	c, teardown, err := setupClient(
+		cortex.Route("catalog_entities", "test-service/groups"),
+		cortex.CatalogEntityGroupsResponse{Groups: req.Groups},
+		AssertRequestMethod(t, "PUT"),
+		AssertRequestBody(t, req),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	err = c.CatalogEntityGroups().Add(context.Background(), "test-service", []string{"payments"})
+	assert.Nil(t, err, "error adding catalog entity groups")
+}
+
+func TestRemoveCatalogEntityGroups(t *testing.T) {
+	req := cortex.CatalogEntityGroupsRequest{
+		Groups: []cortex.CatalogEntityGroupTag{{Tag: "payments"}},
+	}
+	c, teardown, err := setupClient(
+		cortex.Route("catalog_entities", "test-service/groups"),
+		cortex.CatalogEntityGroupsResponse{},
+		AssertRequestMethod(t, "DELETE"),
+		AssertRequestBody(t, req),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	err = c.CatalogEntityGroups().Remove(context.Background(), "test-service", []string{"payments"})
+	assert.Nil(t, err, "error removing catalog entity groups")
+}
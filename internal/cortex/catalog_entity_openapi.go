@@ -44,7 +44,7 @@ func (c *CatalogEntityOpenAPI) ID() string {
 func (c *CatalogEntityOpenAPIClient) Get(ctx context.Context, entityTag string) (CatalogEntityOpenAPI, error) {
 	entity := CatalogEntityOpenAPI{}
 	apiError := ApiError{}
-	response, err := c.Client().Get(Route("catalog_entities", entityTag+"/documentation/openapi")).Receive(&entity, &apiError)
+	response, err := c.client.receive(ctx, c.Client().Get(Route("catalog_entities", entityTag+"/documentation/openapi")), &entity, &apiError)
 	if err != nil {
 		return entity, errors.New("could not get catalog entity OpenAPI spec: " + err.Error())
 	}
@@ -79,7 +79,7 @@ func (c *CatalogEntityOpenAPIClient) Upsert(ctx context.Context, entityTag strin
 
 	req.Force = true
 
-	body, err := c.Client().Put(Route("catalog_entities", entityTag+"/documentation/openapi")).BodyJSON(&req).Receive(&entity, &apiError)
+	body, err := c.client.receive(ctx, c.Client().Put(Route("catalog_entities", entityTag+"/documentation/openapi")).BodyJSON(&req), &entity, &apiError)
 	if err != nil {
 		return entity, fmt.Errorf("failed upserting OpenAPI spec for entity: %+v", err)
 	}
@@ -100,7 +100,7 @@ func (c *CatalogEntityOpenAPIClient) Upsert(ctx context.Context, entityTag strin
 func (c *CatalogEntityOpenAPIClient) Delete(ctx context.Context, entityTag string) error {
 	apiError := ApiError{}
 
-	response, err := c.Client().Delete(Route("catalog_entities", entityTag+"/documentation/openapi")).Receive(nil, &apiError)
+	response, err := c.client.receive(ctx, c.Client().Delete(Route("catalog_entities", entityTag+"/documentation/openapi")), nil, &apiError)
 	if err != nil {
 		return errors.New("could not delete OpenAPI spec: " + err.Error())
 	}
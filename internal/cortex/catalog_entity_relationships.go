@@ -0,0 +1,99 @@
+package cortex
+
+import (
+	"context"
+	"errors"
+	"github.com/dghubble/sling"
+)
+
+type CatalogEntityRelationshipsClientInterface interface {
+	ListDestinations(ctx context.Context, entityTag string, relationshipTypeTag string) ([]CatalogEntityRelationshipEntity, error)
+	AddDestinations(ctx context.Context, entityTag string, relationshipTypeTag string, req CatalogEntityRelationshipDestinationsRequest) error
+	RemoveDestinations(ctx context.Context, entityTag string, relationshipTypeTag string, req CatalogEntityRelationshipDestinationsRequest) error
+}
+
+type CatalogEntityRelationshipsClient struct {
+	client *HttpClient
+}
+
+var _ CatalogEntityRelationshipsClientInterface = &CatalogEntityRelationshipsClient{}
+
+func (c *CatalogEntityRelationshipsClient) Client() *sling.Sling {
+	return c.client.Client()
+}
+
+/***********************************************************************************************************************
+ * Types
+ **********************************************************************************************************************/
+
+// CatalogEntityRelationshipEntity is an entity on the other end of a relationship.
+type CatalogEntityRelationshipEntity struct {
+	Tag  string `json:"tag"`
+	Name string `json:"name,omitempty"`
+}
+
+// CatalogEntityRelationshipDestinationsRequest is the body used to add or remove relationship destinations.
+type CatalogEntityRelationshipDestinationsRequest struct {
+	Destinations []CatalogEntityRelationshipEntity `json:"destinations"`
+}
+
+type CatalogEntityRelationshipDestinationsResponse struct {
+	Destinations []CatalogEntityRelationshipEntity `json:"destinations"`
+}
+
+func (c *CatalogEntityRelationshipsClient) destinationsRoute(entityTag string, relationshipTypeTag string) string {
+	return Route("catalog_entities", entityTag+"/relationships/"+relationshipTypeTag+"/destinations")
+}
+
+/***********************************************************************************************************************
+ * GET /api/v1/catalog/:tag/relationships/:relationshipTypeTag/destinations
+ **********************************************************************************************************************/
+
+func (c *CatalogEntityRelationshipsClient) ListDestinations(ctx context.Context, entityTag string, relationshipTypeTag string) ([]CatalogEntityRelationshipEntity, error) {
+	destinationsResponse := CatalogEntityRelationshipDestinationsResponse{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Get(c.destinationsRoute(entityTag, relationshipTypeTag)), &destinationsResponse, &apiError)
+	if err != nil {
+		return nil, errors.New("could not get catalog entity relationship destinations: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return nil, err
+	}
+
+	return destinationsResponse.Destinations, nil
+}
+
+/***********************************************************************************************************************
+ * POST /api/v1/catalog/:tag/relationships/:relationshipTypeTag/destinations
+ **********************************************************************************************************************/
+
+func (c *CatalogEntityRelationshipsClient) AddDestinations(ctx context.Context, entityTag string, relationshipTypeTag string, req CatalogEntityRelationshipDestinationsRequest) error {
+	destinationsResponse := CatalogEntityRelationshipDestinationsResponse{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Post(c.destinationsRoute(entityTag, relationshipTypeTag)).BodyJSON(&req), &destinationsResponse, &apiError)
+	if err != nil {
+		return errors.New("could not add catalog entity relationship destinations: " + err.Error())
+	}
+
+	return c.client.handleResponseStatus(response, &apiError)
+}
+
+/***********************************************************************************************************************
+ * DELETE /api/v1/catalog/:tag/relationships/:relationshipTypeTag/destinations
+ **********************************************************************************************************************/
+
+func (c *CatalogEntityRelationshipsClient) RemoveDestinations(ctx context.Context, entityTag string, relationshipTypeTag string, req CatalogEntityRelationshipDestinationsRequest) error {
+	destinationsResponse := CatalogEntityRelationshipDestinationsResponse{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Delete(c.destinationsRoute(entityTag, relationshipTypeTag)).BodyJSON(&req), &destinationsResponse, &apiError)
+	if err != nil {
+		return errors.New("could not remove catalog entity relationship destinations: " + err.Error())
+	}
+
+	return c.client.handleResponseStatus(response, &apiError)
+}
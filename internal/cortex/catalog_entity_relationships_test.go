@@ -0,0 +1,57 @@
+package cortex_test
+
+import (
+	"context"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestListCatalogEntityRelationshipDestinations(t *testing.T) {
+	destinations := []cortex.CatalogEntityRelationshipEntity{{Tag: "prod-cluster", Name: "Production cluster"}}
+	c, teardown, err := setupClient(
+		cortex.Route("catalog_entities", "test-service/relationships/runs-on/destinations"),
+		cortex.CatalogEntityRelationshipDestinationsResponse{Destinations: destinations},
+		AssertRequestMethod(t, "GET"),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.CatalogEntityRelationships().ListDestinations(context.Background(), "test-service", "runs-on")
+	assert.Nil(t, err, "error retrieving relationship destinations")
+	assert.Equal(t, destinations, res)
+}
+
+func TestAddCatalogEntityRelationshipDestinations(t *testing.T) {
+	req := cortex.CatalogEntityRelationshipDestinationsRequest{
+		Destinations: []cortex.CatalogEntityRelationshipEntity{{Tag: "prod-cluster"}},
+	}
+	c, teardown, err := setupClient(
+		cortex.Route("catalog_entities", "test-service/relationships/runs-on/destinations"),
+		cortex.CatalogEntityRelationshipDestinationsResponse{Destinations: req.Destinations},
+		AssertRequestMethod(t, "POST"),
+		AssertRequestBody(t, req),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	err = c.CatalogEntityRelationships().AddDestinations(context.Background(), "test-service", "runs-on", req)
+	assert.Nil(t, err, "error adding relationship destinations")
+}
+
+func TestRemoveCatalogEntityRelationshipDestinations(t *testing.T) {
+	req := cortex.CatalogEntityRelationshipDestinationsRequest{
+		Destinations: []cortex.CatalogEntityRelationshipEntity{{Tag: "prod-cluster"}},
+	}
+	c, teardown, err := setupClient(
+		cortex.Route("catalog_entities", "test-service/relationships/runs-on/destinations"),
+		cortex.CatalogEntityRelationshipDestinationsResponse{},
+		AssertRequestMethod(t, "DELETE"),
+		AssertRequestBody(t, req),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	err = c.CatalogEntityRelationships().RemoveDestinations(context.Background(), "test-service", "runs-on", req)
+	assert.Nil(t, err, "error removing relationship destinations")
+}
@@ -0,0 +1,82 @@
+package cortex
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// DefaultProfile is the profile used from the config file when none is specified.
+	DefaultProfile = "default"
+)
+
+// Profile is a named set of credentials from a Cortex CLI config file.
+type Profile struct {
+	Name    string
+	ApiKey  string
+	BaseUrl string
+}
+
+// DefaultConfigFile returns the path of the config file shared with the Cortex CLI, ~/.cortex/config.
+func DefaultConfigFile() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".cortex", "config"), nil
+}
+
+// LoadProfile reads the named profile from a Cortex CLI config file. The file uses the CLI's INI layout:
+//
+//	[default]
+//	api_key = ...
+//	base_url = https://api.getcortexapp.com
+func LoadProfile(path string, name string) (Profile, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return Profile{}, fmt.Errorf("could not open config file %s: %w", path, err)
+	}
+	defer f.Close()
+
+	profile := Profile{Name: name}
+	found := false
+	section := ""
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			found = found || section == name
+			continue
+		}
+		if section != name {
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			key, value, ok = strings.Cut(line, ":")
+		}
+		if !ok {
+			continue
+		}
+		switch strings.TrimSpace(key) {
+		case "api_key":
+			profile.ApiKey = strings.TrimSpace(value)
+		case "base_url":
+			profile.BaseUrl = strings.TrimSpace(value)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return Profile{}, fmt.Errorf("could not read config file %s: %w", path, err)
+	}
+	if !found {
+		return Profile{}, fmt.Errorf("profile %q not found in config file %s", name, path)
+	}
+	return profile, nil
+}
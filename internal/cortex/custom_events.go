@@ -0,0 +1,155 @@
+package cortex
+
+import (
+	"context"
+	"errors"
+	"github.com/dghubble/sling"
+)
+
+type CustomEventsClientInterface interface {
+	Get(ctx context.Context, entityTag string, uuid string) (CustomEvent, error)
+	List(ctx context.Context, entityTag string, params CustomEventListParams) ([]CustomEvent, error)
+	Create(ctx context.Context, entityTag string, req CreateCustomEventRequest) (CustomEvent, error)
+	Delete(ctx context.Context, entityTag string, uuid string) error
+}
+
+type CustomEventsClient struct {
+	client *HttpClient
+}
+
+var _ CustomEventsClientInterface = &CustomEventsClient{}
+
+func (c *CustomEventsClient) Client() *sling.Sling {
+	return c.client.Client()
+}
+
+/***********************************************************************************************************************
+ * Types
+ **********************************************************************************************************************/
+
+// CustomEvent is a timestamped event on a catalog entity's timeline.
+type CustomEvent struct {
+	Uuid        string                 `json:"uuid,omitempty"`
+	Type        string                 `json:"type"`
+	Title       string                 `json:"title"`
+	Description string                 `json:"description,omitempty"`
+	Timestamp   string                 `json:"timestamp"`
+	Url         string                 `json:"url,omitempty"`
+	CustomData  map[string]interface{} `json:"customData,omitempty"`
+}
+
+func (c *CustomEventsClient) route(entityTag string, path string) string {
+	return Route("catalog_entities", entityTag+"/custom-events"+path)
+}
+
+/***********************************************************************************************************************
+ * GET /api/v1/catalog/:tag/custom-events/:uuid
+ **********************************************************************************************************************/
+
+func (c *CustomEventsClient) Get(ctx context.Context, entityTag string, uuid string) (CustomEvent, error) {
+	event := CustomEvent{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Get(c.route(entityTag, "/"+uuid)), &event, &apiError)
+	if err != nil {
+		return event, errors.New("could not get custom event: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return event, err
+	}
+
+	return event, nil
+}
+
+/***********************************************************************************************************************
+ * GET /api/v1/catalog/:tag/custom-events
+ **********************************************************************************************************************/
+
+// CustomEventListParams are the query parameters for the GET /v1/catalog/:tag/custom-events endpoint.
+type CustomEventListParams struct {
+	Type      string `url:"type,omitempty"`
+	StartTime string `url:"startTime,omitempty"`
+	EndTime   string `url:"endTime,omitempty"`
+}
+
+type CustomEventsResponse struct {
+	Events []CustomEvent `json:"events"`
+}
+
+func (c *CustomEventsClient) List(ctx context.Context, entityTag string, params CustomEventListParams) ([]CustomEvent, error) {
+	eventsResponse := CustomEventsResponse{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Get(c.route(entityTag, "")).QueryStruct(&params), &eventsResponse, &apiError)
+	if err != nil {
+		return nil, errors.New("could not get custom events: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return nil, err
+	}
+
+	return eventsResponse.Events, nil
+}
+
+/***********************************************************************************************************************
+ * POST /api/v1/catalog/:tag/custom-events
+ **********************************************************************************************************************/
+
+type CreateCustomEventRequest struct {
+	Type        string                 `json:"type"`
+	Title       string                 `json:"title"`
+	Description string                 `json:"description,omitempty"`
+	Timestamp   string                 `json:"timestamp"`
+	Url         string                 `json:"url,omitempty"`
+	CustomData  map[string]interface{} `json:"customData,omitempty"`
+}
+
+func (e *CustomEvent) ToCreateRequest() CreateCustomEventRequest {
+	return CreateCustomEventRequest{
+		Type:        e.Type,
+		Title:       e.Title,
+		Description: e.Description,
+		Timestamp:   e.Timestamp,
+		Url:         e.Url,
+		CustomData:  e.CustomData,
+	}
+}
+
+func (c *CustomEventsClient) Create(ctx context.Context, entityTag string, req CreateCustomEventRequest) (CustomEvent, error) {
+	event := CustomEvent{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Post(c.route(entityTag, "")).BodyJSON(&req), &event, &apiError)
+	if err != nil {
+		return event, errors.New("could not create custom event: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return event, err
+	}
+
+	return event, nil
+}
+
+/***********************************************************************************************************************
+ * DELETE /api/v1/catalog/:tag/custom-events/:uuid
+ **********************************************************************************************************************/
+
+type DeleteCustomEventResponse struct{}
+
+func (c *CustomEventsClient) Delete(ctx context.Context, entityTag string, uuid string) error {
+	deleteResponse := DeleteCustomEventResponse{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Delete(c.route(entityTag, "/"+uuid)), &deleteResponse, &apiError)
+	if err != nil {
+		return errors.New("could not delete custom event: " + err.Error())
+	}
+
+	return c.client.handleResponseStatus(response, &apiError)
+}
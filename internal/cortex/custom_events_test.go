@@ -0,0 +1,76 @@
+package cortex_test
+
+import (
+	"context"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var testCustomEvent = cortex.CustomEvent{
+	Uuid:        "6b1f3c0e-2a4d-4e8f-b1c9-3d2e5f7a9b0c",
+	Type:        "DATABASE_FAILOVER",
+	Title:       "Enabled cross-region failover",
+	Description: "Failover replica added in us-west-2",
+	Timestamp:   "2024-05-01T12:00:00Z",
+	Url:         "https://app.terraform.io/runs/run-123",
+	CustomData:  map[string]interface{}{"region": "us-west-2"},
+}
+
+func TestGetCustomEvent(t *testing.T) {
+	c, teardown, err := setupClient(
+		cortex.Route("catalog_entities", "test-service/custom-events/"+testCustomEvent.Uuid),
+		testCustomEvent,
+		AssertRequestMethod(t, "GET"),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.CustomEvents().Get(context.Background(), "test-service", testCustomEvent.Uuid)
+	assert.Nil(t, err, "error retrieving a custom event")
+	assert.Equal(t, testCustomEvent, res)
+}
+
+func TestListCustomEvents(t *testing.T) {
+	c, teardown, err := setupClient(
+		cortex.Route("catalog_entities", "test-service/custom-events"),
+		cortex.CustomEventsResponse{Events: []cortex.CustomEvent{testCustomEvent}},
+		AssertRequestMethod(t, "GET"),
+		AssertRequestURI(t, cortex.Route("catalog_entities", "test-service/custom-events")+"?type=DATABASE_FAILOVER"),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.CustomEvents().List(context.Background(), "test-service", cortex.CustomEventListParams{Type: "DATABASE_FAILOVER"})
+	assert.Nil(t, err, "error listing custom events")
+	assert.Equal(t, []cortex.CustomEvent{testCustomEvent}, res)
+}
+
+func TestCreateCustomEvent(t *testing.T) {
+	req := testCustomEvent.ToCreateRequest()
+	c, teardown, err := setupClient(
+		cortex.Route("catalog_entities", "test-service/custom-events"),
+		testCustomEvent,
+		AssertRequestMethod(t, "POST"),
+		AssertRequestBody(t, req),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.CustomEvents().Create(context.Background(), "test-service", req)
+	assert.Nil(t, err, "error creating a custom event")
+	assert.Equal(t, testCustomEvent, res)
+}
+
+func TestDeleteCustomEvent(t *testing.T) {
+	c, teardown, err := setupClient(
+		cortex.Route("catalog_entities", "test-service/custom-events/"+testCustomEvent.Uuid),
+		cortex.DeleteCustomEventResponse{},
+		AssertRequestMethod(t, "DELETE"),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	err = c.CustomEvents().Delete(context.Background(), "test-service", testCustomEvent.Uuid)
+	assert.Nil(t, err, "error deleting a custom event")
+}
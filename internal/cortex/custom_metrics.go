@@ -0,0 +1,255 @@
+package cortex
+
+import (
+	"context"
+	"errors"
+	"github.com/dghubble/sling"
+)
+
+type CustomMetricsClientInterface interface {
+	Get(ctx context.Context, tag string) (CustomMetric, error)
+	Create(ctx context.Context, req CreateCustomMetricRequest) (CustomMetric, error)
+	Update(ctx context.Context, tag string, req UpdateCustomMetricRequest) (CustomMetric, error)
+	Delete(ctx context.Context, tag string) error
+	Values(ctx context.Context, tag string, params *CustomMetricValuesParams) (*CustomMetricValuesResponse, error)
+	AllValues(ctx context.Context, tag string, entityTag string) ([]CustomMetricValue, error)
+}
+
+type CustomMetricsClient struct {
+	client *HttpClient
+}
+
+var _ CustomMetricsClientInterface = &CustomMetricsClient{}
+
+func (c *CustomMetricsClient) Client() *sling.Sling {
+	return c.client.Client()
+}
+
+/***********************************************************************************************************************
+ * Types
+ **********************************************************************************************************************/
+
+const (
+	CustomMetricFunctionSum     = "SUM"
+	CustomMetricFunctionAverage = "AVERAGE"
+	CustomMetricFunctionMin     = "MIN"
+	CustomMetricFunctionMax     = "MAX"
+	CustomMetricFunctionCount   = "COUNT"
+	CustomMetricFunctionMedian  = "MEDIAN"
+
+	CustomMetricSourceDeploys      = "DEPLOYS"
+	CustomMetricSourceCustomEvents = "CUSTOM_EVENTS"
+	CustomMetricSourceCustomData   = "CUSTOM_DATA"
+)
+
+// CustomMetric is an Eng Intelligence metric, calculated for each entity matching Filter. The metric is defined either
+// by a CQL Query or by an Aggregation, never both.
+type CustomMetric struct {
+	Tag         string                   `json:"tag"`
+	Name        string                   `json:"name"`
+	Description string                   `json:"description,omitempty"`
+	Unit        string                   `json:"unit,omitempty"`
+	Query       string                   `json:"query,omitempty"`
+	Aggregation *CustomMetricAggregation `json:"aggregation,omitempty"`
+	Filter      ScorecardFilter          `json:"filter,omitempty"`
+}
+
+// CustomMetricAggregation applies Function to the values of Key in Source, over the last LookbackDays days. Key is the
+// custom data key or custom event type, and is not used for deploys.
+type CustomMetricAggregation struct {
+	Function     string `json:"function"`
+	Source       string `json:"source"`
+	Key          string `json:"key,omitempty"`
+	LookbackDays int64  `json:"lookbackDays,omitempty"`
+}
+
+/***********************************************************************************************************************
+ * GET /api/v1/eng-intel/custom-metrics/:tag
+ **********************************************************************************************************************/
+
+func (c *CustomMetricsClient) Get(ctx context.Context, tag string) (CustomMetric, error) {
+	metric := CustomMetric{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Get(Route("custom_metrics", tag)), &metric, &apiError)
+	if err != nil {
+		return metric, errors.New("could not get custom metric: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return metric, err
+	}
+
+	return metric, nil
+}
+
+/***********************************************************************************************************************
+ * POST /api/v1/eng-intel/custom-metrics
+ **********************************************************************************************************************/
+
+type CreateCustomMetricRequest struct {
+	Tag         string                   `json:"tag"`
+	Name        string                   `json:"name"`
+	Description string                   `json:"description,omitempty"`
+	Unit        string                   `json:"unit,omitempty"`
+	Query       string                   `json:"query,omitempty"`
+	Aggregation *CustomMetricAggregation `json:"aggregation,omitempty"`
+	Filter      ScorecardFilter          `json:"filter,omitempty"`
+}
+
+func (r *CustomMetric) ToCreateRequest() CreateCustomMetricRequest {
+	return CreateCustomMetricRequest{
+		Tag:         r.Tag,
+		Name:        r.Name,
+		Description: r.Description,
+		Unit:        r.Unit,
+		Query:       r.Query,
+		Aggregation: r.Aggregation,
+		Filter:      r.Filter,
+	}
+}
+
+func (c *CustomMetricsClient) Create(ctx context.Context, req CreateCustomMetricRequest) (CustomMetric, error) {
+	metric := CustomMetric{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Post(Route("custom_metrics", "")).BodyJSON(&req), &metric, &apiError)
+	if err != nil {
+		return metric, errors.New("could not create custom metric: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return metric, err
+	}
+
+	return metric, nil
+}
+
+/***********************************************************************************************************************
+ * PUT /api/v1/eng-intel/custom-metrics/:tag
+ **********************************************************************************************************************/
+
+type UpdateCustomMetricRequest struct {
+	Name        string                   `json:"name"`
+	Description string                   `json:"description,omitempty"`
+	Unit        string                   `json:"unit,omitempty"`
+	Query       string                   `json:"query,omitempty"`
+	Aggregation *CustomMetricAggregation `json:"aggregation,omitempty"`
+	Filter      ScorecardFilter          `json:"filter,omitempty"`
+}
+
+func (r *CustomMetric) ToUpdateRequest() UpdateCustomMetricRequest {
+	return UpdateCustomMetricRequest{
+		Name:        r.Name,
+		Description: r.Description,
+		Unit:        r.Unit,
+		Query:       r.Query,
+		Aggregation: r.Aggregation,
+		Filter:      r.Filter,
+	}
+}
+
+func (c *CustomMetricsClient) Update(ctx context.Context, tag string, req UpdateCustomMetricRequest) (CustomMetric, error) {
+	metric := CustomMetric{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Put(Route("custom_metrics", tag)).BodyJSON(&req), &metric, &apiError)
+	if err != nil {
+		return metric, errors.New("could not update custom metric: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return metric, err
+	}
+
+	return metric, nil
+}
+
+/***********************************************************************************************************************
+ * DELETE /api/v1/eng-intel/custom-metrics/:tag - Delete a custom metric
+ **********************************************************************************************************************/
+
+type DeleteCustomMetricResponse struct{}
+
+func (c *CustomMetricsClient) Delete(ctx context.Context, tag string) error {
+	deleteResponse := DeleteCustomMetricResponse{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Delete(Route("custom_metrics", tag)), &deleteResponse, &apiError)
+	if err != nil {
+		return errors.New("could not delete custom metric: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+/***********************************************************************************************************************
+ * GET /api/v1/eng-intel/custom-metrics/:tag/values
+ **********************************************************************************************************************/
+
+// CustomMetricValuesParams are the query parameters for the GET /v1/eng-intel/custom-metrics/:tag/values endpoint.
+type CustomMetricValuesParams struct {
+	EntityTag string `url:"entityTag,omitempty"`
+	PageSize  int    `url:"pageSize,omitempty"`
+	Page      int    `url:"page,omitempty"`
+}
+
+// CustomMetricValuesResponse is the response from the GET /v1/eng-intel/custom-metrics/:tag/values endpoint.
+type CustomMetricValuesResponse struct {
+	Values     []CustomMetricValue `json:"values"`
+	Page       int                 `json:"page"`
+	TotalPages int                 `json:"totalPages"`
+	Total      int                 `json:"total"`
+}
+
+// CustomMetricValue is the current value of a custom metric for a single entity. CalculatedAt is an RFC 3339
+// timestamp.
+type CustomMetricValue struct {
+	EntityTag    string  `json:"entityTag"`
+	EntityName   string  `json:"entityName,omitempty"`
+	Value        float64 `json:"value"`
+	CalculatedAt string  `json:"calculatedAt,omitempty"`
+}
+
+func (c *CustomMetricsClient) Values(ctx context.Context, tag string, params *CustomMetricValuesParams) (*CustomMetricValuesResponse, error) {
+	valuesResponse := &CustomMetricValuesResponse{}
+	apiError := &ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Get(Route("custom_metrics", tag+"/values")).QueryStruct(params), valuesResponse, apiError)
+	if err != nil {
+		return nil, errors.New("could not get custom metric values: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, apiError)
+	if err != nil {
+		return nil, err
+	}
+
+	return valuesResponse, nil
+}
+
+// AllValues returns the current value of the custom metric for every entity, fetching every page. If entityTag is
+// set, only that entity is returned.
+func (c *CustomMetricsClient) AllValues(ctx context.Context, tag string, entityTag string) ([]CustomMetricValue, error) {
+	values := []CustomMetricValue{}
+	params := &CustomMetricValuesParams{EntityTag: entityTag, PageSize: 250}
+	for {
+		valuesResponse, err := c.Values(ctx, tag, params)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, valuesResponse.Values...)
+		if valuesResponse.Page >= valuesResponse.TotalPages-1 || len(valuesResponse.Values) == 0 {
+			return values, nil
+		}
+		params.Page++
+	}
+}
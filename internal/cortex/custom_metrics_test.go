@@ -0,0 +1,106 @@
+package cortex_test
+
+import (
+	"context"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var testCustomMetric = cortex.CustomMetric{
+	Tag:         "weekly-deploys",
+	Name:        "Weekly Deploys",
+	Description: "Deploys over the last week",
+	Unit:        "deploys",
+	Aggregation: &cortex.CustomMetricAggregation{
+		Function:     cortex.CustomMetricFunctionCount,
+		Source:       cortex.CustomMetricSourceDeploys,
+		LookbackDays: 7,
+	},
+	Filter: cortex.ScorecardFilter{
+		Kind: "GENERIC",
+		Types: &cortex.ScorecardFilterTypes{
+			Include: []string{"service"},
+		},
+	},
+}
+
+func TestGetCustomMetric(t *testing.T) {
+	c, teardown, err := setupClient(cortex.Route("custom_metrics", testCustomMetric.Tag), testCustomMetric, AssertRequestMethod(t, "GET"))
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.CustomMetrics().Get(context.Background(), testCustomMetric.Tag)
+	assert.Nil(t, err, "error retrieving a custom metric")
+	assert.Equal(t, testCustomMetric, res)
+}
+
+func TestCreateCustomMetric(t *testing.T) {
+	req := testCustomMetric.ToCreateRequest()
+	c, teardown, err := setupClient(
+		cortex.Route("custom_metrics", ""),
+		testCustomMetric,
+		AssertRequestMethod(t, "POST"),
+		AssertRequestBody(t, req),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.CustomMetrics().Create(context.Background(), req)
+	assert.Nil(t, err, "error creating a custom metric")
+	assert.Equal(t, testCustomMetric, res)
+}
+
+func TestUpdateCustomMetric(t *testing.T) {
+	updated := testCustomMetric
+	updated.Aggregation = nil
+	updated.Query = "deploys(lookback=duration(\"P7D\")).count"
+	req := updated.ToUpdateRequest()
+	c, teardown, err := setupClient(
+		cortex.Route("custom_metrics", testCustomMetric.Tag),
+		updated,
+		AssertRequestMethod(t, "PUT"),
+		AssertRequestBody(t, req),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.CustomMetrics().Update(context.Background(), testCustomMetric.Tag, req)
+	assert.Nil(t, err, "error updating a custom metric")
+	assert.Equal(t, updated, res)
+}
+
+func TestDeleteCustomMetric(t *testing.T) {
+	c, teardown, err := setupClient(
+		cortex.Route("custom_metrics", testCustomMetric.Tag),
+		cortex.DeleteCustomMetricResponse{},
+		AssertRequestMethod(t, "DELETE"),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	err = c.CustomMetrics().Delete(context.Background(), testCustomMetric.Tag)
+	assert.Nil(t, err, "error deleting a custom metric")
+}
+
+func TestCustomMetricValues(t *testing.T) {
+	value := cortex.CustomMetricValue{
+		EntityTag:    "test-service",
+		EntityName:   "Test Service",
+		Value:        12,
+		CalculatedAt: "2026-10-01T12:00:00Z",
+	}
+	route := cortex.Route("custom_metrics", testCustomMetric.Tag+"/values")
+	c, teardown, err := setupClient(
+		route,
+		cortex.CustomMetricValuesResponse{Values: []cortex.CustomMetricValue{value}, Page: 0, TotalPages: 1, Total: 1},
+		AssertRequestMethod(t, "GET"),
+		AssertRequestURI(t, route+"?entityTag=test-service&pageSize=250"),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.CustomMetrics().AllValues(context.Background(), testCustomMetric.Tag, "test-service")
+	assert.Nil(t, err, "error retrieving custom metric values")
+	assert.Equal(t, []cortex.CustomMetricValue{value}, res)
+}
@@ -61,7 +61,7 @@ func (c *DepartmentsClient) Get(ctx context.Context, tag string) (Department, er
 	params := DepartmentGetParams{
 		DepartmentTag: tag,
 	}
-	body, err := c.Client().Get(Route("departments", "")).QueryStruct(&params).Receive(&department, &apiError)
+	body, err := c.client.receive(ctx, c.Client().Get(Route("departments", "")).QueryStruct(&params), &department, &apiError)
 	if err != nil {
 		return department, fmt.Errorf("failed getting department: %+v", err)
 	}
@@ -98,7 +98,7 @@ func (c *DepartmentsClient) Create(ctx context.Context, req CreateDepartmentRequ
 	department := Department{}
 	apiError := ApiError{}
 
-	body, err := c.Client().Post(Route("departments", "")).BodyJSON(&req).Receive(&department, &apiError)
+	body, err := c.client.receive(ctx, c.Client().Post(Route("departments", "")).BodyJSON(&req), &department, &apiError)
 	if err != nil {
 		return department, fmt.Errorf("failed creating department: %+v", err)
 	}
@@ -134,7 +134,7 @@ func (c *DepartmentsClient) Update(ctx context.Context, tag string, req UpdateDe
 	department := Department{}
 	apiError := ApiError{}
 
-	body, err := c.Client().Put(Route("departments", tag)).BodyJSON(&req).Receive(&department, &apiError)
+	body, err := c.client.receive(ctx, c.Client().Put(Route("departments", tag)).BodyJSON(&req), &department, &apiError)
 	if err != nil {
 		return department, errors.New("could not update department: " + err.Error())
 	}
@@ -163,7 +163,7 @@ func (c *DepartmentsClient) Delete(ctx context.Context, tag string) error {
 		DepartmentTag: tag,
 	}
 
-	body, err := c.Client().Delete(Route("departments", "")).QueryStruct(&params).Receive(&response, &apiError)
+	body, err := c.client.receive(ctx, c.Client().Delete(Route("departments", "")).QueryStruct(&params), &response, &apiError)
 	if err != nil {
 		return errors.New("could not delete department: " + err.Error())
 	}
@@ -0,0 +1,201 @@
+package cortex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/dghubble/sling"
+)
+
+type DeploysClientInterface interface {
+	Get(ctx context.Context, entityTag string, uuid string) (Deploy, error)
+	List(ctx context.Context, entityTag string, params *DeployListParams) (*DeploysResponse, error)
+	Create(ctx context.Context, entityTag string, req CreateDeployRequest) (Deploy, error)
+	Delete(ctx context.Context, entityTag string, uuid string) error
+	DeleteByFilter(ctx context.Context, entityTag string, params DeleteDeploysParams) error
+}
+
+type DeploysClient struct {
+	client *HttpClient
+}
+
+var _ DeploysClientInterface = &DeploysClient{}
+
+func (c *DeploysClient) Client() *sling.Sling {
+	return c.client.Client()
+}
+
+/***********************************************************************************************************************
+ * Types
+ **********************************************************************************************************************/
+
+const (
+	DeployTypeDeploy   = "DEPLOY"
+	DeployTypeScale    = "SCALE"
+	DeployTypeRollback = "ROLLBACK"
+	DeployTypeRestart  = "RESTART"
+)
+
+// Deploy is a deployment event recorded against a catalog entity.
+type Deploy struct {
+	Uuid        string                 `json:"uuid,omitempty"`
+	Title       string                 `json:"title"`
+	Timestamp   string                 `json:"timestamp,omitempty"`
+	Type        string                 `json:"type"`
+	Sha         string                 `json:"sha,omitempty"`
+	Environment string                 `json:"environment,omitempty"`
+	Url         string                 `json:"url,omitempty"`
+	Deployer    *Deployer              `json:"deployer,omitempty"`
+	CustomData  map[string]interface{} `json:"customData,omitempty"`
+}
+
+type Deployer struct {
+	Name  string `json:"name,omitempty"`
+	Email string `json:"email,omitempty"`
+}
+
+func (c *DeploysClient) route(entityTag string, path string) string {
+	return Route("catalog_entities", entityTag+"/deploys"+path)
+}
+
+/***********************************************************************************************************************
+ * GET /api/v1/catalog/:tag/deploys
+ **********************************************************************************************************************/
+
+// DeployListParams are the query parameters for the GET /v1/catalog/:tag/deploys endpoint.
+type DeployListParams struct {
+	Page     int `url:"page,omitempty"`
+	PageSize int `url:"pageSize,omitempty"`
+}
+
+// DeploysResponse is a page of deploys, most recent first.
+type DeploysResponse struct {
+	Deployments []Deploy `json:"deployments"`
+	Page        int      `json:"page"`
+	TotalPages  int      `json:"totalPages"`
+	Total       int      `json:"total"`
+}
+
+func (c *DeploysClient) List(ctx context.Context, entityTag string, params *DeployListParams) (*DeploysResponse, error) {
+	deploysResponse := &DeploysResponse{}
+	apiError := &ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Get(c.route(entityTag, "")).QueryStruct(params), deploysResponse, apiError)
+	if err != nil {
+		return nil, errors.New("could not get deploys: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, apiError)
+	if err != nil {
+		return nil, err
+	}
+
+	return deploysResponse, nil
+}
+
+// Get finds a deploy by UUID. The API cannot look up a single deploy, so this pages through the entity's deploys,
+// most recent first. Returns ApiErrorNotFound if there is no such deploy.
+func (c *DeploysClient) Get(ctx context.Context, entityTag string, uuid string) (Deploy, error) {
+	params := &DeployListParams{PageSize: 250}
+	for {
+		deploysResponse, err := c.List(ctx, entityTag, params)
+		if err != nil {
+			return Deploy{}, err
+		}
+		for _, deploy := range deploysResponse.Deployments {
+			if deploy.Uuid == uuid {
+				return deploy, nil
+			}
+		}
+		if deploysResponse.Page >= deploysResponse.TotalPages-1 || len(deploysResponse.Deployments) == 0 {
+			return Deploy{}, fmt.Errorf("deploy %s of %s: %w", uuid, entityTag, ApiErrorNotFound)
+		}
+		params.Page++
+	}
+}
+
+/***********************************************************************************************************************
+ * POST /api/v1/catalog/:tag/deploys
+ **********************************************************************************************************************/
+
+type CreateDeployRequest struct {
+	Title       string                 `json:"title"`
+	Timestamp   string                 `json:"timestamp,omitempty"`
+	Type        string                 `json:"type"`
+	Sha         string                 `json:"sha,omitempty"`
+	Environment string                 `json:"environment,omitempty"`
+	Url         string                 `json:"url,omitempty"`
+	Deployer    *Deployer              `json:"deployer,omitempty"`
+	CustomData  map[string]interface{} `json:"customData,omitempty"`
+}
+
+func (d *Deploy) ToCreateRequest() CreateDeployRequest {
+	return CreateDeployRequest{
+		Title:       d.Title,
+		Timestamp:   d.Timestamp,
+		Type:        d.Type,
+		Sha:         d.Sha,
+		Environment: d.Environment,
+		Url:         d.Url,
+		Deployer:    d.Deployer,
+		CustomData:  d.CustomData,
+	}
+}
+
+func (c *DeploysClient) Create(ctx context.Context, entityTag string, req CreateDeployRequest) (Deploy, error) {
+	deploy := Deploy{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Post(c.route(entityTag, "")).BodyJSON(&req), &deploy, &apiError)
+	if err != nil {
+		return deploy, errors.New("could not create deploy: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return deploy, err
+	}
+
+	return deploy, nil
+}
+
+/***********************************************************************************************************************
+ * DELETE /api/v1/catalog/:tag/deploys/:uuid
+ **********************************************************************************************************************/
+
+type DeleteDeployResponse struct{}
+
+func (c *DeploysClient) Delete(ctx context.Context, entityTag string, uuid string) error {
+	deleteResponse := DeleteDeployResponse{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Delete(c.route(entityTag, "/"+uuid)), &deleteResponse, &apiError)
+	if err != nil {
+		return errors.New("could not delete deploy: " + err.Error())
+	}
+
+	return c.client.handleResponseStatus(response, &apiError)
+}
+
+/***********************************************************************************************************************
+ * DELETE /api/v1/catalog/:tag/deploys
+ **********************************************************************************************************************/
+
+// DeleteDeploysParams select the deploys to delete. Every deploy of the entity matching all the set fields is deleted.
+type DeleteDeploysParams struct {
+	Environment string `url:"environment,omitempty"`
+	Sha         string `url:"sha,omitempty"`
+	Type        string `url:"type,omitempty"`
+}
+
+func (c *DeploysClient) DeleteByFilter(ctx context.Context, entityTag string, params DeleteDeploysParams) error {
+	deleteResponse := DeleteDeployResponse{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Delete(c.route(entityTag, "")).QueryStruct(&params), &deleteResponse, &apiError)
+	if err != nil {
+		return errors.New("could not delete deploys: " + err.Error())
+	}
+
+	return c.client.handleResponseStatus(response, &apiError)
+}
@@ -0,0 +1,95 @@
+package cortex_test
+
+import (
+	"context"
+	"errors"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var testDeploy = cortex.Deploy{
+	Uuid:        "0d3c2a1e-5d8b-4f0e-9d1a-0a4d1e6b7c3f",
+	Title:       "Deploy abc123",
+	Timestamp:   "2024-05-01T12:00:00Z",
+	Type:        cortex.DeployTypeDeploy,
+	Sha:         "abc123",
+	Environment: "production",
+	Deployer:    &cortex.Deployer{Name: "Terraform", Email: "terraform@example.com"},
+	CustomData:  map[string]interface{}{"pipeline": "infra"},
+}
+
+func TestListDeploys(t *testing.T) {
+	c, teardown, err := setupClient(
+		cortex.Route("catalog_entities", "test-service/deploys"),
+		cortex.DeploysResponse{Deployments: []cortex.Deploy{testDeploy}, Page: 0, TotalPages: 1, Total: 1},
+		AssertRequestMethod(t, "GET"),
+		AssertRequestURI(t, cortex.Route("catalog_entities", "test-service/deploys")+"?page=1&pageSize=10"),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.Deploys().List(context.Background(), "test-service", &cortex.DeployListParams{Page: 1, PageSize: 10})
+	assert.Nil(t, err, "error listing deploys")
+	assert.Equal(t, []cortex.Deploy{testDeploy}, res.Deployments)
+}
+
+func TestGetDeploy(t *testing.T) {
+	c, teardown, err := setupClient(
+		cortex.Route("catalog_entities", "test-service/deploys"),
+		cortex.DeploysResponse{Deployments: []cortex.Deploy{testDeploy}, Page: 0, TotalPages: 1, Total: 1},
+		AssertRequestMethod(t, "GET"),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.Deploys().Get(context.Background(), "test-service", testDeploy.Uuid)
+	assert.Nil(t, err, "error retrieving a deploy")
+	assert.Equal(t, testDeploy, res)
+
+	_, err = c.Deploys().Get(context.Background(), "test-service", "missing")
+	assert.True(t, errors.Is(err, cortex.ApiErrorNotFound), "expected not found, got %v", err)
+}
+
+func TestCreateDeploy(t *testing.T) {
+	req := testDeploy.ToCreateRequest()
+	c, teardown, err := setupClient(
+		cortex.Route("catalog_entities", "test-service/deploys"),
+		testDeploy,
+		AssertRequestMethod(t, "POST"),
+		AssertRequestBody(t, req),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.Deploys().Create(context.Background(), "test-service", req)
+	assert.Nil(t, err, "error creating a deploy")
+	assert.Equal(t, testDeploy, res)
+}
+
+func TestDeleteDeploy(t *testing.T) {
+	c, teardown, err := setupClient(
+		cortex.Route("catalog_entities", "test-service/deploys/"+testDeploy.Uuid),
+		cortex.DeleteDeployResponse{},
+		AssertRequestMethod(t, "DELETE"),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	err = c.Deploys().Delete(context.Background(), "test-service", testDeploy.Uuid)
+	assert.Nil(t, err, "error deleting a deploy")
+}
+
+func TestDeleteDeploysByFilter(t *testing.T) {
+	c, teardown, err := setupClient(
+		cortex.Route("catalog_entities", "test-service/deploys"),
+		cortex.DeleteDeployResponse{},
+		AssertRequestMethod(t, "DELETE"),
+		AssertRequestURI(t, cortex.Route("catalog_entities", "test-service/deploys")+"?environment=staging"),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	err = c.Deploys().DeleteByFilter(context.Background(), "test-service", cortex.DeleteDeploysParams{Environment: "staging"})
+	assert.Nil(t, err, "error deleting deploys")
+}
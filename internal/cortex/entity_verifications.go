@@ -0,0 +1,197 @@
+package cortex
+
+import (
+	"context"
+	"errors"
+	"github.com/dghubble/sling"
+)
+
+type EntityVerificationsClientInterface interface {
+	GetPeriod(ctx context.Context, id string) (EntityVerificationPeriod, error)
+	CreatePeriod(ctx context.Context, req UpsertEntityVerificationPeriodRequest) (EntityVerificationPeriod, error)
+	UpdatePeriod(ctx context.Context, id string, req UpsertEntityVerificationPeriodRequest) (EntityVerificationPeriod, error)
+	DeletePeriod(ctx context.Context, id string) error
+	ListStatuses(ctx context.Context, params *EntityVerificationStatusListParams) (*EntityVerificationStatusesResponse, error)
+}
+
+type EntityVerificationsClient struct {
+	client *HttpClient
+}
+
+var _ EntityVerificationsClientInterface = &EntityVerificationsClient{}
+
+func (c *EntityVerificationsClient) Client() *sling.Sling {
+	return c.client.Client()
+}
+
+/***********************************************************************************************************************
+ * Types
+ **********************************************************************************************************************/
+
+const (
+	EntityVerificationCadenceMonthly      = "MONTHLY"
+	EntityVerificationCadenceQuarterly    = "QUARTERLY"
+	EntityVerificationCadenceSemiAnnually = "SEMI_ANNUALLY"
+	EntityVerificationCadenceAnnually     = "ANNUALLY"
+
+	EntityVerificationStatusVerified   = "VERIFIED"
+	EntityVerificationStatusUnverified = "UNVERIFIED"
+	EntityVerificationStatusExpired    = "EXPIRED"
+)
+
+// EntityVerificationPeriod asks the owners of the entities matching Filter to confirm that their entity's metadata is
+// correct, once per Cadence. Periods start on StartDate, a YYYY-MM-DD date.
+type EntityVerificationPeriod struct {
+	Id          string          `json:"id,omitempty"`
+	Name        string          `json:"name"`
+	Description string          `json:"description,omitempty"`
+	Cadence     string          `json:"cadence"`
+	StartDate   string          `json:"startDate,omitempty"`
+	Filter      ScorecardFilter `json:"filter"`
+}
+
+// EntityVerificationStatus is whether an entity has been verified in its current verification period.
+type EntityVerificationStatus struct {
+	EntityTag  string `json:"entityTag"`
+	PeriodId   string `json:"periodId"`
+	Status     string `json:"status"`
+	VerifiedAt string `json:"verifiedAt,omitempty"`
+	VerifiedBy string `json:"verifiedBy,omitempty"`
+	ExpiresAt  string `json:"expiresAt,omitempty"`
+}
+
+/***********************************************************************************************************************
+ * GET /api/v1/entity-verification/periods/:id
+ **********************************************************************************************************************/
+
+func (c *EntityVerificationsClient) GetPeriod(ctx context.Context, id string) (EntityVerificationPeriod, error) {
+	period := EntityVerificationPeriod{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Get(Route("entity_verification", "periods/"+id)), &period, &apiError)
+	if err != nil {
+		return period, errors.New("could not get entity verification period: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return period, err
+	}
+
+	return period, nil
+}
+
+/***********************************************************************************************************************
+ * POST /api/v1/entity-verification/periods
+ **********************************************************************************************************************/
+
+type UpsertEntityVerificationPeriodRequest struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description,omitempty"`
+	Cadence     string          `json:"cadence"`
+	StartDate   string          `json:"startDate,omitempty"`
+	Filter      ScorecardFilter `json:"filter"`
+}
+
+func (r *EntityVerificationPeriod) ToUpsertRequest() UpsertEntityVerificationPeriodRequest {
+	return UpsertEntityVerificationPeriodRequest{
+		Name:        r.Name,
+		Description: r.Description,
+		Cadence:     r.Cadence,
+		StartDate:   r.StartDate,
+		Filter:      r.Filter,
+	}
+}
+
+func (c *EntityVerificationsClient) CreatePeriod(ctx context.Context, req UpsertEntityVerificationPeriodRequest) (EntityVerificationPeriod, error) {
+	period := EntityVerificationPeriod{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Post(Route("entity_verification", "periods")).BodyJSON(&req), &period, &apiError)
+	if err != nil {
+		return period, errors.New("could not create entity verification period: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return period, err
+	}
+
+	return period, nil
+}
+
+/***********************************************************************************************************************
+ * PUT /api/v1/entity-verification/periods/:id
+ **********************************************************************************************************************/
+
+func (c *EntityVerificationsClient) UpdatePeriod(ctx context.Context, id string, req UpsertEntityVerificationPeriodRequest) (EntityVerificationPeriod, error) {
+	period := EntityVerificationPeriod{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Put(Route("entity_verification", "periods/"+id)).BodyJSON(&req), &period, &apiError)
+	if err != nil {
+		return period, errors.New("could not update entity verification period: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return period, err
+	}
+
+	return period, nil
+}
+
+/***********************************************************************************************************************
+ * DELETE /api/v1/entity-verification/periods/:id
+ **********************************************************************************************************************/
+
+type DeleteEntityVerificationPeriodResponse struct{}
+
+func (c *EntityVerificationsClient) DeletePeriod(ctx context.Context, id string) error {
+	deleteResponse := DeleteEntityVerificationPeriodResponse{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Delete(Route("entity_verification", "periods/"+id)), &deleteResponse, &apiError)
+	if err != nil {
+		return errors.New("could not delete entity verification period: " + err.Error())
+	}
+
+	return c.client.handleResponseStatus(response, &apiError)
+}
+
+/***********************************************************************************************************************
+ * GET /api/v1/entity-verification/statuses
+ **********************************************************************************************************************/
+
+// EntityVerificationStatusListParams are the query parameters for the GET /v1/entity-verification/statuses endpoint.
+type EntityVerificationStatusListParams struct {
+	PeriodId string `url:"periodId,omitempty"`
+	Status   string `url:"status,omitempty"`
+	Page     int    `url:"page,omitempty"`
+	PageSize int    `url:"pageSize,omitempty"`
+}
+
+// EntityVerificationStatusesResponse is a page of entity verification statuses.
+type EntityVerificationStatusesResponse struct {
+	Statuses   []EntityVerificationStatus `json:"statuses"`
+	Page       int                        `json:"page"`
+	TotalPages int                        `json:"totalPages"`
+	Total      int                        `json:"total"`
+}
+
+func (c *EntityVerificationsClient) ListStatuses(ctx context.Context, params *EntityVerificationStatusListParams) (*EntityVerificationStatusesResponse, error) {
+	statusesResponse := &EntityVerificationStatusesResponse{}
+	apiError := &ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Get(Route("entity_verification", "statuses")).QueryStruct(params), statusesResponse, apiError)
+	if err != nil {
+		return nil, errors.New("could not get entity verification statuses: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, apiError)
+	if err != nil {
+		return nil, err
+	}
+
+	return statusesResponse, nil
+}
@@ -0,0 +1,103 @@
+package cortex_test
+
+import (
+	"context"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var testEntityVerificationPeriod = cortex.EntityVerificationPeriod{
+	Id:        "evp-1",
+	Name:      "Quarterly service attestation",
+	Cadence:   cortex.EntityVerificationCadenceQuarterly,
+	StartDate: "2026-01-01",
+	Filter: cortex.ScorecardFilter{
+		Kind:  "GENERIC",
+		Types: &cortex.ScorecardFilterTypes{Include: []string{"service"}},
+	},
+}
+
+func TestGetEntityVerificationPeriod(t *testing.T) {
+	c, teardown, err := setupClient(
+		cortex.Route("entity_verification", "periods/"+testEntityVerificationPeriod.Id),
+		testEntityVerificationPeriod,
+		AssertRequestMethod(t, "GET"),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.EntityVerifications().GetPeriod(context.Background(), testEntityVerificationPeriod.Id)
+	assert.Nil(t, err, "error retrieving an entity verification period")
+	assert.Equal(t, testEntityVerificationPeriod, res)
+}
+
+func TestCreateEntityVerificationPeriod(t *testing.T) {
+	req := testEntityVerificationPeriod.ToUpsertRequest()
+	c, teardown, err := setupClient(
+		cortex.Route("entity_verification", "periods"),
+		testEntityVerificationPeriod,
+		AssertRequestMethod(t, "POST"),
+		AssertRequestBody(t, req),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.EntityVerifications().CreatePeriod(context.Background(), req)
+	assert.Nil(t, err, "error creating an entity verification period")
+	assert.Equal(t, testEntityVerificationPeriod, res)
+}
+
+func TestUpdateEntityVerificationPeriod(t *testing.T) {
+	updated := testEntityVerificationPeriod
+	updated.Cadence = cortex.EntityVerificationCadenceAnnually
+	req := updated.ToUpsertRequest()
+	c, teardown, err := setupClient(
+		cortex.Route("entity_verification", "periods/"+testEntityVerificationPeriod.Id),
+		updated,
+		AssertRequestMethod(t, "PUT"),
+		AssertRequestBody(t, req),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.EntityVerifications().UpdatePeriod(context.Background(), testEntityVerificationPeriod.Id, req)
+	assert.Nil(t, err, "error updating an entity verification period")
+	assert.Equal(t, updated, res)
+}
+
+func TestDeleteEntityVerificationPeriod(t *testing.T) {
+	c, teardown, err := setupClient(
+		cortex.Route("entity_verification", "periods/"+testEntityVerificationPeriod.Id),
+		cortex.DeleteEntityVerificationPeriodResponse{},
+		AssertRequestMethod(t, "DELETE"),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	err = c.EntityVerifications().DeletePeriod(context.Background(), testEntityVerificationPeriod.Id)
+	assert.Nil(t, err, "error deleting an entity verification period")
+}
+
+func TestListEntityVerificationStatuses(t *testing.T) {
+	status := cortex.EntityVerificationStatus{
+		EntityTag:  "products-service",
+		PeriodId:   testEntityVerificationPeriod.Id,
+		Status:     cortex.EntityVerificationStatusVerified,
+		VerifiedAt: "2026-01-15T10:00:00Z",
+		VerifiedBy: "owner@example.com",
+		ExpiresAt:  "2026-04-01T00:00:00Z",
+	}
+	c, teardown, err := setupClient(
+		cortex.Route("entity_verification", "statuses"),
+		cortex.EntityVerificationStatusesResponse{Statuses: []cortex.EntityVerificationStatus{status}, Page: 0, TotalPages: 1, Total: 1},
+		AssertRequestMethod(t, "GET"),
+		AssertRequestURI(t, cortex.Route("entity_verification", "statuses")+"?pageSize=10&periodId=evp-1"),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.EntityVerifications().ListStatuses(context.Background(), &cortex.EntityVerificationStatusListParams{PeriodId: "evp-1", PageSize: 10})
+	assert.Nil(t, err, "error listing entity verification statuses")
+	assert.Equal(t, []cortex.EntityVerificationStatus{status}, res.Statuses)
+}
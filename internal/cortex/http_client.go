@@ -2,15 +2,13 @@ package cortex
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
-	"os"
 
 	"github.com/dghubble/sling"
-	"github.com/motemen/go-loghttp"
-	_ "github.com/motemen/go-loghttp/global" // Just this line!
 )
 
 const (
@@ -25,6 +23,19 @@ var BaseUris = map[string]string{
 	"catalog_entities":     "/api/v1/catalog/",
 	"open_api":             "/api/v1/open-api",
 	"resource_definitions": "/api/v1/catalog/definitions/",
+	"initiatives":          "/api/v1/initiatives/",
+	"relationship_types":   "/api/v1/relationship-types/",
+	"workflows":            "/api/v1/workflows/",
+	"scaffolder_templates": "/api/v1/scaffolder/templates/",
+	"integrations":         "/api/v1/",
+	"entity_verification":  "/api/v1/entity-verification/",
+	"api_keys":             "/api/v1/api-keys/",
+	"roles":                "/api/v1/roles/",
+	"role_assignments":     "/api/v1/role-assignments/",
+	"permissions":          "/api/v1/permissions",
+	"users":                "/api/v1/users/",
+	"audit_logs":           "/api/v1/audit-logs",
+	"custom_metrics":       "/api/v1/eng-intel/custom-metrics/",
 }
 
 func Route(domain string, path string) string {
@@ -36,12 +47,14 @@ func Route(domain string, path string) string {
 }
 
 type HttpClient struct {
-	ctx        context.Context
-	client     *sling.Sling
-	yamlClient *sling.Sling
-	baseUrl    string
-	token      string
-	version    string
+	client      *sling.Sling
+	yamlClient  *sling.Sling
+	httpClient  *http.Client
+	tlsConfig   *tls.Config
+	proxyUrl    *url.URL
+	baseUrl     string
+	tokenSource TokenSource
+	version     string
 }
 
 type OptionDelegator func(c *HttpClient) error
@@ -55,22 +68,50 @@ func NewClient(opts ...OptionDelegator) (*HttpClient, error) {
 		}
 	}
 
+	transport, err := c.transport()
+	if err != nil {
+		return nil, err
+	}
 	hc := &http.Client{}
-	if os.Getenv("HTTP_DEBUG") == "1" {
-		hc.Transport = &loghttp.Transport{}
+	if c.httpClient != nil {
+		*hc = *c.httpClient
 	}
+	hc.Transport = newLoggingTransport(transport)
+
 	c.client = sling.New().Doer(hc).Base(c.baseUrl).
 		Set("User-Agent", fmt.Sprintf("%s (%s)", UserAgentPrefix, c.version)).
-		Set("Authorization", fmt.Sprintf("Bearer %s", c.token)).
 		ResponseDecoder(jsonDecoder{})
 	c.yamlClient = sling.New().Doer(hc).Base(c.baseUrl).
 		Set("User-Agent", fmt.Sprintf("%s (%s)", UserAgentPrefix, c.version)).
-		Set("Authorization", fmt.Sprintf("Bearer %s", c.token)).
 		ResponseDecoder(yamlDecoder{})
 
 	return c, nil
 }
 
+// transport returns the round tripper requests are sent through, applying any configured TLS and proxy settings.
+func (c *HttpClient) transport() (http.RoundTripper, error) {
+	var base http.RoundTripper = http.DefaultTransport
+	if c.httpClient != nil && c.httpClient.Transport != nil {
+		base = c.httpClient.Transport
+	}
+	if c.tlsConfig == nil && c.proxyUrl == nil {
+		return base, nil
+	}
+
+	t, ok := base.(*http.Transport)
+	if !ok {
+		return nil, fmt.Errorf("cannot apply TLS or proxy settings to a custom %T transport", base)
+	}
+	t = t.Clone()
+	if c.tlsConfig != nil {
+		t.TLSClientConfig = c.tlsConfig
+	}
+	if c.proxyUrl != nil {
+		t.Proxy = http.ProxyURL(c.proxyUrl)
+	}
+	return t, nil
+}
+
 func WithVersion(version string) func(*HttpClient) error {
 	return func(c *HttpClient) error {
 		if version == "" {
@@ -81,14 +122,6 @@ func WithVersion(version string) func(*HttpClient) error {
 	}
 }
 
-// WithContext Specify the context for the cortex client to use.
-func WithContext(ctx context.Context) func(*HttpClient) error {
-	return func(c *HttpClient) error {
-		c.ctx = ctx
-		return nil
-	}
-}
-
 // WithURL Specify the base URL for the cortex client to connect to.
 func WithURL(baseUrl string) func(*HttpClient) error {
 	return func(c *HttpClient) error {
@@ -109,7 +142,59 @@ func WithToken(token string) func(*HttpClient) error {
 		if token == "" {
 			return errors.New("cannot specify empty token")
 		}
-		c.token = token
+		c.tokenSource = StaticTokenSource(token)
+		return nil
+	}
+}
+
+// WithTokenSource Specify where the cortex client reads its API token from, for tokens that are rotated or fetched
+// from an external credential store.
+func WithTokenSource(tokenSource TokenSource) func(*HttpClient) error {
+	return func(c *HttpClient) error {
+		if tokenSource == nil {
+			return errors.New("cannot specify nil token source")
+		}
+		c.tokenSource = tokenSource
+		return nil
+	}
+}
+
+// WithHTTPClient Specify the underlying HTTP client for the cortex client to send requests with.
+func WithHTTPClient(hc *http.Client) func(*HttpClient) error {
+	return func(c *HttpClient) error {
+		if hc == nil {
+			return errors.New("cannot specify nil HTTP client")
+		}
+		c.httpClient = hc
+		return nil
+	}
+}
+
+// WithTLSConfig Specify the TLS configuration used to connect to the Cortex API, e.g. for a custom CA or mutual TLS.
+func WithTLSConfig(tlsConfig *tls.Config) func(*HttpClient) error {
+	return func(c *HttpClient) error {
+		if tlsConfig == nil {
+			return errors.New("cannot specify nil TLS config")
+		}
+		c.tlsConfig = tlsConfig
+		return nil
+	}
+}
+
+// WithProxyURL Specify an HTTP(S) proxy to send all requests through, overriding the HTTPS_PROXY/HTTP_PROXY environment.
+func WithProxyURL(proxyUrl string) func(*HttpClient) error {
+	return func(c *HttpClient) error {
+		if proxyUrl == "" {
+			return errors.New("cannot specify empty proxy URL")
+		}
+		u, err := url.Parse(proxyUrl)
+		if err != nil {
+			return err
+		}
+		if u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("invalid proxy URL %q: must include a scheme and host", proxyUrl)
+		}
+		c.proxyUrl = u
 		return nil
 	}
 }
@@ -121,15 +206,52 @@ func (c *HttpClient) handleResponseStatus(response *http.Response, apiError *Api
 	case code == 404:
 		return ApiErrorNotFound
 	case code == 401:
-		return fmt.Errorf("%s\n%s", ApiErrorUnauthorized, apiError)
+		return fmt.Errorf("%w\n%s", ApiErrorUnauthorized, apiError)
+	case code == 403:
+		return fmt.Errorf("%w\n%s", ApiErrorForbidden, apiError)
 	default:
 		return fmt.Errorf("%d request failed with error: %+v", code, apiError.String())
 	}
 }
 
+// receive builds the request from the given sling, binds it to ctx and sends it. Sling's own Receive always uses
+// context.Background(), so every request must go through here for cancellation and deadlines to reach the transport.
+func (c *HttpClient) receive(ctx context.Context, s *sling.Sling, successV, failureV interface{}) (*http.Response, error) {
+	req, err := s.Request()
+	if err != nil {
+		return nil, err
+	}
+	if err := c.authorize(ctx, req); err != nil {
+		return nil, err
+	}
+	return s.Do(req.WithContext(ctx), successV, failureV)
+}
+
+// authorize sets the Authorization header from the client's token source. Tokens are resolved per request so that
+// rotated credentials are picked up.
+func (c *HttpClient) authorize(ctx context.Context, req *http.Request) error {
+	if c.tokenSource == nil {
+		return nil
+	}
+	token, err := c.tokenSource.Token(ctx)
+	if err != nil {
+		return fmt.Errorf("could not get API token: %w", err)
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	return nil
+}
+
+// PingParams are the query parameters for the request issued by Ping.
+type PingParams struct {
+	PageSize int `url:"pageSize"`
+}
+
+// Ping checks connectivity and credentials by listing a single catalog entity, an authenticated request every token
+// is allowed to make. Authentication failures wrap ApiErrorUnauthorized/ApiErrorForbidden, and transport errors are
+// returned as-is so callers can inspect them.
 func (c *HttpClient) Ping(ctx context.Context) error {
 	apiError := new(ApiError)
-	response, err := c.client.Get("/").Receive(nil, apiError)
+	response, err := c.receive(ctx, c.Client().Get(Route("catalog_entities", "")).QueryStruct(PingParams{PageSize: 1}), nil, apiError)
 	if err != nil {
 		return err
 	}
@@ -154,6 +276,18 @@ func (c *HttpClient) CatalogEntityCustomData() CatalogEntityCustomDataClientInte
 	return &CatalogEntityCustomDataClient{client: c}
 }
 
+func (c *HttpClient) CatalogEntityDependencies() CatalogEntityDependenciesClientInterface {
+	return &CatalogEntityDependenciesClient{client: c}
+}
+
+func (c *HttpClient) CatalogEntityRelationships() CatalogEntityRelationshipsClientInterface {
+	return &CatalogEntityRelationshipsClient{client: c}
+}
+
+func (c *HttpClient) CatalogEntityGroups() CatalogEntityGroupsClientInterface {
+	return &CatalogEntityGroupsClient{client: c}
+}
+
 func (c *HttpClient) CatalogEntityOpenAPI() CatalogEntityOpenAPIClientInterface {
 	return &CatalogEntityOpenAPIClient{client: c}
 }
@@ -170,6 +304,70 @@ func (c *HttpClient) Scorecards() ScorecardsClientInterface {
 	return &ScorecardsClient{client: c}
 }
 
+func (c *HttpClient) ScorecardRuleExemptions() ScorecardRuleExemptionsClientInterface {
+	return &ScorecardRuleExemptionsClient{client: c}
+}
+
 func (c *HttpClient) ResourceDefinitions() ResourceDefinitionsClientInterface {
 	return &ResourceDefinitionsClient{client: c}
 }
+
+func (c *HttpClient) Initiatives() InitiativesClientInterface {
+	return &InitiativesClient{client: c}
+}
+
+func (c *HttpClient) RelationshipTypes() RelationshipTypesClientInterface {
+	return &RelationshipTypesClient{client: c}
+}
+
+func (c *HttpClient) Deploys() DeploysClientInterface {
+	return &DeploysClient{client: c}
+}
+
+func (c *HttpClient) CustomEvents() CustomEventsClientInterface {
+	return &CustomEventsClient{client: c}
+}
+
+func (c *HttpClient) Workflows() WorkflowsClientInterface {
+	return &WorkflowsClient{client: c}
+}
+
+func (c *HttpClient) ScaffolderTemplates() ScaffolderTemplatesClientInterface {
+	return &ScaffolderTemplatesClient{client: c}
+}
+
+func (c *HttpClient) IntegrationConfigurations() IntegrationConfigurationsClientInterface {
+	return &IntegrationConfigurationsClient{client: c}
+}
+
+func (c *HttpClient) EntityVerifications() EntityVerificationsClientInterface {
+	return &EntityVerificationsClient{client: c}
+}
+
+func (c *HttpClient) ApiKeys() ApiKeysClientInterface {
+	return &ApiKeysClient{client: c}
+}
+
+func (c *HttpClient) Roles() RolesClientInterface {
+	return &RolesClient{client: c}
+}
+
+func (c *HttpClient) RoleAssignments() RoleAssignmentsClientInterface {
+	return &RoleAssignmentsClient{client: c}
+}
+
+func (c *HttpClient) Permissions() PermissionsClientInterface {
+	return &PermissionsClient{client: c}
+}
+
+func (c *HttpClient) Users() UsersClientInterface {
+	return &UsersClient{client: c}
+}
+
+func (c *HttpClient) AuditLogs() AuditLogsClientInterface {
+	return &AuditLogsClient{client: c}
+}
+
+func (c *HttpClient) CustomMetrics() CustomMetricsClientInterface {
+	return &CustomMetricsClient{client: c}
+}
@@ -12,6 +12,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 )
 
 type RequestTest func(req *http.Request)
@@ -50,7 +51,6 @@ func buildClient(mux *http.ServeMux) (*cortex.HttpClient, func(), error) {
 	ts := httptest.NewServer(mux)
 
 	c, err := cortex.NewClient(
-		cortex.WithContext(context.Background()),
 		cortex.WithURL(ts.URL),
 		cortex.WithToken("test"),
 		cortex.WithVersion("test"),
@@ -67,6 +67,27 @@ func buildClient(mux *http.ServeMux) (*cortex.HttpClient, func(), error) {
 	return c, teardown, nil
 }
 
+// setupBlockingClient returns a client whose server never responds on its own; requests only complete once the
+// caller's context is done, which lets tests assert that cancellation and deadlines reach the transport.
+func setupBlockingClient() (*cortex.HttpClient, func(), error) {
+	release := make(chan struct{})
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, req *http.Request) {
+		select {
+		case <-req.Context().Done():
+		case <-release:
+		}
+	})
+	c, teardown, err := buildClient(mux)
+	if err != nil {
+		return nil, nil, err
+	}
+	return c, func() {
+		close(release)
+		teardown()
+	}, nil
+}
+
 var pingResponseJSON = `{}`
 
 func TestClientInitialization(t *testing.T) {
@@ -150,6 +171,18 @@ func AssertRequestBody(t *testing.T, src interface{}) RequestTest {
 	}
 }
 
+// AssertRequestBodyString asserts the exact JSON request body, for tests where it matters which fields are null
+// and which are omitted.
+func AssertRequestBodyString(t *testing.T, expected string) RequestTest {
+	return func(req *http.Request) {
+		t.Run("AssertRequestBodyString", func(t *testing.T) {
+			b, err := io.ReadAll(req.Body)
+			assert.Nil(t, err, "could not read request body")
+			assert.JSONEq(t, expected, string(b))
+		})
+	}
+}
+
 func AssertRequestBodyYaml(t *testing.T, src interface{}) RequestTest {
 	return func(req *http.Request) {
 		t.Run("AssertRequestBodyRaw", func(t *testing.T) {
@@ -181,3 +214,462 @@ func AssertRequestURI(t *testing.T, desiredURI string) RequestTest {
 		})
 	}
 }
+
+// contextPropagationCalls exercises every request-issuing method on every client.
+var contextPropagationCalls = map[string]func(ctx context.Context, c *cortex.HttpClient) error{
+	"Ping": func(ctx context.Context, c *cortex.HttpClient) error {
+		return c.Ping(ctx)
+	},
+	"CatalogEntities.Get": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.CatalogEntities().Get(ctx, "test")
+		return err
+	},
+	"CatalogEntities.GetFromDescriptor": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.CatalogEntities().GetFromDescriptor(ctx, "test")
+		return err
+	},
+	"CatalogEntities.List": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.CatalogEntities().List(ctx, &cortex.CatalogEntityListParams{})
+		return err
+	},
+	"CatalogEntities.ListAll": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.CatalogEntities().ListAll(ctx, &cortex.CatalogEntityListParams{})
+		return err
+	},
+	"CatalogEntities.Upsert": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.CatalogEntities().Upsert(ctx, cortex.UpsertCatalogEntityRequest{Info: cortex.CatalogEntityData{Tag: "test"}})
+		return err
+	},
+	"CatalogEntities.Delete": func(ctx context.Context, c *cortex.HttpClient) error {
+		return c.CatalogEntities().Delete(ctx, "test")
+	},
+	"CatalogEntities.Archive": func(ctx context.Context, c *cortex.HttpClient) error {
+		return c.CatalogEntities().Archive(ctx, "test")
+	},
+	"CatalogEntities.Unarchive": func(ctx context.Context, c *cortex.HttpClient) error {
+		return c.CatalogEntities().Unarchive(ctx, "test")
+	},
+	"CatalogEntityCustomData.Get": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.CatalogEntityCustomData().Get(ctx, "test", "key")
+		return err
+	},
+	"CatalogEntityCustomData.List": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.CatalogEntityCustomData().List(ctx, "test", cortex.CatalogEntityCustomDataListParams{})
+		return err
+	},
+	"CatalogEntityCustomData.Upsert": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.CatalogEntityCustomData().Upsert(ctx, "test", cortex.UpsertCatalogEntityCustomDataRequest{Key: "key"})
+		return err
+	},
+	"CatalogEntityCustomData.Delete": func(ctx context.Context, c *cortex.HttpClient) error {
+		return c.CatalogEntityCustomData().Delete(ctx, "test", "key")
+	},
+	"CatalogEntityOpenAPI.Get": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.CatalogEntityOpenAPI().Get(ctx, "test")
+		return err
+	},
+	"CatalogEntityOpenAPI.Upsert": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.CatalogEntityOpenAPI().Upsert(ctx, "test", cortex.UpsertCatalogEntityOpenAPIRequest{Spec: "{}"})
+		return err
+	},
+	"CatalogEntityOpenAPI.Delete": func(ctx context.Context, c *cortex.HttpClient) error {
+		return c.CatalogEntityOpenAPI().Delete(ctx, "test")
+	},
+	"CatalogEntityDependencies.Get": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.CatalogEntityDependencies().Get(ctx, "caller", "callee", cortex.CatalogEntityDependencyParams{})
+		return err
+	},
+	"CatalogEntityDependencies.Create": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.CatalogEntityDependencies().Create(ctx, "caller", "callee", cortex.CatalogEntityDependencyParams{}, cortex.UpsertCatalogEntityDependencyRequest{})
+		return err
+	},
+	"CatalogEntityDependencies.Update": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.CatalogEntityDependencies().Update(ctx, "caller", "callee", cortex.CatalogEntityDependencyParams{}, cortex.UpsertCatalogEntityDependencyRequest{})
+		return err
+	},
+	"CatalogEntityDependencies.Delete": func(ctx context.Context, c *cortex.HttpClient) error {
+		return c.CatalogEntityDependencies().Delete(ctx, "caller", "callee", cortex.CatalogEntityDependencyParams{})
+	},
+	"CatalogEntityGroups.Get": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.CatalogEntityGroups().Get(ctx, "test")
+		return err
+	},
+	"CatalogEntityGroups.Add": func(ctx context.Context, c *cortex.HttpClient) error {
+		return c.CatalogEntityGroups().Add(ctx, "test", []string{"group"})
+	},
+	"CatalogEntityGroups.Remove": func(ctx context.Context, c *cortex.HttpClient) error {
+		return c.CatalogEntityGroups().Remove(ctx, "test", []string{"group"})
+	},
+	"CatalogEntityRelationships.ListDestinations": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.CatalogEntityRelationships().ListDestinations(ctx, "test", "runs-on")
+		return err
+	},
+	"CatalogEntityRelationships.AddDestinations": func(ctx context.Context, c *cortex.HttpClient) error {
+		return c.CatalogEntityRelationships().AddDestinations(ctx, "test", "runs-on", cortex.CatalogEntityRelationshipDestinationsRequest{})
+	},
+	"CatalogEntityRelationships.RemoveDestinations": func(ctx context.Context, c *cortex.HttpClient) error {
+		return c.CatalogEntityRelationships().RemoveDestinations(ctx, "test", "runs-on", cortex.CatalogEntityRelationshipDestinationsRequest{})
+	},
+	"Teams.Get": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.Teams().Get(ctx, "test")
+		return err
+	},
+	"Teams.List": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.Teams().List(ctx, &cortex.TeamListParams{})
+		return err
+	},
+	"Teams.Create": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.Teams().Create(ctx, cortex.CreateTeamRequest{TeamTag: "test"})
+		return err
+	},
+	"Teams.Update": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.Teams().Update(ctx, "test", cortex.UpdateTeamRequest{})
+		return err
+	},
+	"Teams.Delete": func(ctx context.Context, c *cortex.HttpClient) error {
+		return c.Teams().Delete(ctx, "test")
+	},
+	"Teams.Archive": func(ctx context.Context, c *cortex.HttpClient) error {
+		return c.Teams().Archive(ctx, "test")
+	},
+	"Teams.Unarchive": func(ctx context.Context, c *cortex.HttpClient) error {
+		return c.Teams().Unarchive(ctx, "test")
+	},
+	"Departments.Get": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.Departments().Get(ctx, "test")
+		return err
+	},
+	"Departments.Create": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.Departments().Create(ctx, cortex.CreateDepartmentRequest{Tag: "test"})
+		return err
+	},
+	"Departments.Update": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.Departments().Update(ctx, "test", cortex.UpdateDepartmentRequest{})
+		return err
+	},
+	"Departments.Delete": func(ctx context.Context, c *cortex.HttpClient) error {
+		return c.Departments().Delete(ctx, "test")
+	},
+	"Workflows.Get": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.Workflows().Get(ctx, "test")
+		return err
+	},
+	"Workflows.GetDescriptor": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.Workflows().GetDescriptor(ctx, "test")
+		return err
+	},
+	"Workflows.Upsert": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.Workflows().Upsert(ctx, cortex.Workflow{Tag: "test"})
+		return err
+	},
+	"Workflows.UpsertDescriptor": func(ctx context.Context, c *cortex.HttpClient) error {
+		return c.Workflows().UpsertDescriptor(ctx, "tag: test")
+	},
+	"Workflows.Delete": func(ctx context.Context, c *cortex.HttpClient) error {
+		return c.Workflows().Delete(ctx, "test")
+	},
+	"ScaffolderTemplates.Get": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.ScaffolderTemplates().Get(ctx, "test")
+		return err
+	},
+	"ScaffolderTemplates.List": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.ScaffolderTemplates().List(ctx, &cortex.ScaffolderTemplateListParams{})
+		return err
+	},
+	"ScaffolderTemplates.Create": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.ScaffolderTemplates().Create(ctx, cortex.UpsertScaffolderTemplateRequest{Tag: "test"})
+		return err
+	},
+	"ScaffolderTemplates.Update": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.ScaffolderTemplates().Update(ctx, "test", cortex.UpsertScaffolderTemplateRequest{Tag: "test"})
+		return err
+	},
+	"ScaffolderTemplates.Delete": func(ctx context.Context, c *cortex.HttpClient) error {
+		return c.ScaffolderTemplates().Delete(ctx, "test")
+	},
+	"IntegrationConfigurations.Get": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.IntegrationConfigurations().Get(ctx, cortex.IntegrationDatadog, "test")
+		return err
+	},
+	"IntegrationConfigurations.Create": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.IntegrationConfigurations().Create(ctx, cortex.IntegrationDatadog, cortex.IntegrationConfiguration{"alias": "test"})
+		return err
+	},
+	"IntegrationConfigurations.Update": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.IntegrationConfigurations().Update(ctx, cortex.IntegrationDatadog, "test", cortex.IntegrationConfiguration{"alias": "test"})
+		return err
+	},
+	"IntegrationConfigurations.Delete": func(ctx context.Context, c *cortex.HttpClient) error {
+		return c.IntegrationConfigurations().Delete(ctx, cortex.IntegrationDatadog, "test")
+	},
+	"EntityVerifications.GetPeriod": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.EntityVerifications().GetPeriod(ctx, "test")
+		return err
+	},
+	"EntityVerifications.CreatePeriod": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.EntityVerifications().CreatePeriod(ctx, cortex.UpsertEntityVerificationPeriodRequest{Name: "test"})
+		return err
+	},
+	"EntityVerifications.UpdatePeriod": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.EntityVerifications().UpdatePeriod(ctx, "test", cortex.UpsertEntityVerificationPeriodRequest{Name: "test"})
+		return err
+	},
+	"EntityVerifications.DeletePeriod": func(ctx context.Context, c *cortex.HttpClient) error {
+		return c.EntityVerifications().DeletePeriod(ctx, "test")
+	},
+	"EntityVerifications.ListStatuses": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.EntityVerifications().ListStatuses(ctx, &cortex.EntityVerificationStatusListParams{})
+		return err
+	},
+	"ApiKeys.Get": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.ApiKeys().Get(ctx, "test")
+		return err
+	},
+	"ApiKeys.GetSecret": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.ApiKeys().GetSecret(ctx, "test")
+		return err
+	},
+	"ApiKeys.Create": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.ApiKeys().Create(ctx, cortex.UpsertApiKeyRequest{Name: "test"})
+		return err
+	},
+	"ApiKeys.Update": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.ApiKeys().Update(ctx, "test", cortex.UpsertApiKeyRequest{Name: "test"})
+		return err
+	},
+	"ApiKeys.Delete": func(ctx context.Context, c *cortex.HttpClient) error {
+		return c.ApiKeys().Delete(ctx, "test")
+	},
+	"Roles.Get": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.Roles().Get(ctx, "test")
+		return err
+	},
+	"Roles.Create": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.Roles().Create(ctx, cortex.CreateRoleRequest{Tag: "test"})
+		return err
+	},
+	"Roles.Update": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.Roles().Update(ctx, "test", cortex.UpdateRoleRequest{})
+		return err
+	},
+	"Roles.Delete": func(ctx context.Context, c *cortex.HttpClient) error {
+		return c.Roles().Delete(ctx, "test")
+	},
+	"RoleAssignments.Get": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.RoleAssignments().Get(ctx, "test")
+		return err
+	},
+	"RoleAssignments.Create": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.RoleAssignments().Create(ctx, cortex.CreateRoleAssignmentRequest{RoleTag: "test"})
+		return err
+	},
+	"RoleAssignments.Delete": func(ctx context.Context, c *cortex.HttpClient) error {
+		return c.RoleAssignments().Delete(ctx, "test")
+	},
+	"Permissions.List": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.Permissions().List(ctx)
+		return err
+	},
+	"Users.Get": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.Users().Get(ctx, "test@example.com")
+		return err
+	},
+	"Users.List": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.Users().List(ctx, &cortex.UserListParams{})
+		return err
+	},
+	"AuditLogs.List": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.AuditLogs().List(ctx, &cortex.AuditLogListParams{})
+		return err
+	},
+	"AuditLogs.ListAll": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.AuditLogs().ListAll(ctx, &cortex.AuditLogListParams{})
+		return err
+	},
+	"CustomMetrics.Get": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.CustomMetrics().Get(ctx, "test")
+		return err
+	},
+	"CustomMetrics.Create": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.CustomMetrics().Create(ctx, cortex.CreateCustomMetricRequest{Tag: "test"})
+		return err
+	},
+	"CustomMetrics.Update": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.CustomMetrics().Update(ctx, "test", cortex.UpdateCustomMetricRequest{})
+		return err
+	},
+	"CustomMetrics.Delete": func(ctx context.Context, c *cortex.HttpClient) error {
+		return c.CustomMetrics().Delete(ctx, "test")
+	},
+	"CustomMetrics.Values": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.CustomMetrics().Values(ctx, "test", &cortex.CustomMetricValuesParams{})
+		return err
+	},
+	"Scorecards.Get": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.Scorecards().Get(ctx, "test")
+		return err
+	},
+	"Scorecards.Upsert": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.Scorecards().Upsert(ctx, cortex.Scorecard{Tag: "test"})
+		return err
+	},
+	"Scorecards.Delete": func(ctx context.Context, c *cortex.HttpClient) error {
+		return c.Scorecards().Delete(ctx, "test")
+	},
+	"Scorecards.Scores": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.Scorecards().Scores(ctx, "test", &cortex.ScorecardScoresParams{})
+		return err
+	},
+	"Scorecards.AllScores": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.Scorecards().AllScores(ctx, "test", "")
+		return err
+	},
+	"Scorecards.LastEvaluated": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.Scorecards().LastEvaluated(ctx, "test", "")
+		return err
+	},
+	"Scorecards.Evaluate": func(ctx context.Context, c *cortex.HttpClient) error {
+		return c.Scorecards().Evaluate(ctx, "test", "")
+	},
+	"ScorecardRuleExemptions.Get": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.ScorecardRuleExemptions().Get(ctx, "test", "entity", "rule")
+		return err
+	},
+	"ScorecardRuleExemptions.List": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.ScorecardRuleExemptions().List(ctx, "test", cortex.ScorecardRuleExemptionListParams{})
+		return err
+	},
+	"ScorecardRuleExemptions.Request": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.ScorecardRuleExemptions().Request(ctx, "test", "entity", cortex.RequestScorecardRuleExemptionRequest{RuleIdentifier: "rule"})
+		return err
+	},
+	"ScorecardRuleExemptions.Approve": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.ScorecardRuleExemptions().Approve(ctx, "test", "entity", "rule")
+		return err
+	},
+	"ScorecardRuleExemptions.Revoke": func(ctx context.Context, c *cortex.HttpClient) error {
+		return c.ScorecardRuleExemptions().Revoke(ctx, "test", "entity", cortex.RevokeScorecardRuleExemptionRequest{RuleIdentifier: "rule"})
+	},
+	"ResourceDefinitions.Get": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.ResourceDefinitions().Get(ctx, "test")
+		return err
+	},
+	"ResourceDefinitions.List": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.ResourceDefinitions().List(ctx, &cortex.ResourceDefinitionListParams{})
+		return err
+	},
+	"ResourceDefinitions.Create": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.ResourceDefinitions().Create(ctx, cortex.CreateResourceDefinitionRequest{Type: "test"})
+		return err
+	},
+	"ResourceDefinitions.Update": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.ResourceDefinitions().Update(ctx, "test", cortex.UpdateResourceDefinitionRequest{})
+		return err
+	},
+	"ResourceDefinitions.CreateEntityType": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.ResourceDefinitions().CreateEntityType(ctx, cortex.CreateEntityTypeRequest{Type: "test"})
+		return err
+	},
+	"ResourceDefinitions.UpdateEntityType": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.ResourceDefinitions().UpdateEntityType(ctx, "test", cortex.UpdateEntityTypeRequest{})
+		return err
+	},
+	"ResourceDefinitions.Delete": func(ctx context.Context, c *cortex.HttpClient) error {
+		return c.ResourceDefinitions().Delete(ctx, "test")
+	},
+	"Initiatives.Get": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.Initiatives().Get(ctx, "test")
+		return err
+	},
+	"Initiatives.Create": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.Initiatives().Create(ctx, cortex.CreateInitiativeRequest{Name: "test"})
+		return err
+	},
+	"Initiatives.Update": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.Initiatives().Update(ctx, "test", cortex.UpdateInitiativeRequest{})
+		return err
+	},
+	"Initiatives.Delete": func(ctx context.Context, c *cortex.HttpClient) error {
+		return c.Initiatives().Delete(ctx, "test")
+	},
+	"Deploys.Get": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.Deploys().Get(ctx, "test", "uuid")
+		return err
+	},
+	"Deploys.List": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.Deploys().List(ctx, "test", &cortex.DeployListParams{})
+		return err
+	},
+	"Deploys.Create": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.Deploys().Create(ctx, "test", cortex.CreateDeployRequest{Title: "test"})
+		return err
+	},
+	"Deploys.Delete": func(ctx context.Context, c *cortex.HttpClient) error {
+		return c.Deploys().Delete(ctx, "test", "uuid")
+	},
+	"Deploys.DeleteByFilter": func(ctx context.Context, c *cortex.HttpClient) error {
+		return c.Deploys().DeleteByFilter(ctx, "test", cortex.DeleteDeploysParams{Environment: "prod"})
+	},
+	"CustomEvents.Get": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.CustomEvents().Get(ctx, "test", "uuid")
+		return err
+	},
+	"CustomEvents.List": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.CustomEvents().List(ctx, "test", cortex.CustomEventListParams{})
+		return err
+	},
+	"CustomEvents.Create": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.CustomEvents().Create(ctx, "test", cortex.CreateCustomEventRequest{Title: "test"})
+		return err
+	},
+	"CustomEvents.Delete": func(ctx context.Context, c *cortex.HttpClient) error {
+		return c.CustomEvents().Delete(ctx, "test", "uuid")
+	},
+	"RelationshipTypes.Get": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.RelationshipTypes().Get(ctx, "test")
+		return err
+	},
+	"RelationshipTypes.Create": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.RelationshipTypes().Create(ctx, cortex.UpsertRelationshipTypeRequest{Tag: "test"})
+		return err
+	},
+	"RelationshipTypes.Update": func(ctx context.Context, c *cortex.HttpClient) error {
+		_, err := c.RelationshipTypes().Update(ctx, "test", cortex.UpsertRelationshipTypeRequest{Tag: "test"})
+		return err
+	},
+	"RelationshipTypes.Delete": func(ctx context.Context, c *cortex.HttpClient) error {
+		return c.RelationshipTypes().Delete(ctx, "test")
+	},
+}
+
+func TestClientContextCancellation(t *testing.T) {
+	for name, call := range contextPropagationCalls {
+		t.Run(name, func(t *testing.T) {
+			c, teardown, err := setupBlockingClient()
+			assert.Nil(t, err, "could not setup client")
+			defer teardown()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			time.AfterFunc(50*time.Millisecond, cancel)
+
+			err = call(ctx, c)
+			assert.ErrorContains(t, err, context.Canceled.Error())
+		})
+	}
+}
+
+func TestClientContextDeadline(t *testing.T) {
+	for name, call := range contextPropagationCalls {
+		t.Run(name, func(t *testing.T) {
+			c, teardown, err := setupBlockingClient()
+			assert.Nil(t, err, "could not setup client")
+			defer teardown()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+
+			err = call(ctx, c)
+			assert.ErrorContains(t, err, context.DeadlineExceeded.Error())
+		})
+	}
+}
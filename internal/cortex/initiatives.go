@@ -0,0 +1,184 @@
+package cortex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/dghubble/sling"
+)
+
+type InitiativesClientInterface interface {
+	Get(ctx context.Context, cid string) (Initiative, error)
+	Create(ctx context.Context, req CreateInitiativeRequest) (Initiative, error)
+	Update(ctx context.Context, cid string, req UpdateInitiativeRequest) (Initiative, error)
+	Delete(ctx context.Context, cid string) error
+}
+
+type InitiativesClient struct {
+	client *HttpClient
+}
+
+var _ InitiativesClientInterface = &InitiativesClient{}
+
+func (c *InitiativesClient) Client() *sling.Sling {
+	return c.client.Client()
+}
+
+/***********************************************************************************************************************
+ * Types
+ **********************************************************************************************************************/
+
+// Initiative is a time-boxed goal on a scorecard: entities matching the filter are expected to reach the emphasized
+// levels, or pass the emphasized rules, by the target date.
+type Initiative struct {
+	Cid                  string                          `json:"cid,omitempty"`
+	Name                 string                          `json:"name"`
+	Description          string                          `json:"description,omitempty"`
+	ScorecardTag         string                          `json:"scorecardTag"`
+	TargetDate           string                          `json:"targetDate"`
+	IsDraft              bool                            `json:"isDraft"`
+	EmphasizedLevels     []InitiativeLevel               `json:"emphasizedLevels"`
+	EmphasizedRules      []InitiativeRule                `json:"emphasizedRules"`
+	Filter               *ScorecardFilter                `json:"filter,omitempty"`
+	NotificationSchedule *InitiativeNotificationSchedule `json:"notificationSchedule,omitempty"`
+}
+
+// InitiativeLevel references a level on the scorecard's ladder by name.
+type InitiativeLevel struct {
+	LevelName string `json:"levelName"`
+}
+
+// InitiativeRule references a rule on the scorecard by its expression.
+type InitiativeRule struct {
+	Expression string `json:"expression"`
+}
+
+type InitiativeNotificationSchedule struct {
+	IsDisabled    bool     `json:"isDisabled"`
+	TimeInterval  int64    `json:"timeInterval,omitempty"`
+	TimeUnit      string   `json:"timeUnit,omitempty"`
+	ReplyToEmails []string `json:"replyToEmails,omitempty"`
+}
+
+// Configured returns true if the schedule differs from the API's default of enabled notifications.
+func (s *InitiativeNotificationSchedule) Configured() bool {
+	return s != nil && (s.IsDisabled || s.TimeInterval > 0 || s.TimeUnit != "" || len(s.ReplyToEmails) > 0)
+}
+
+/***********************************************************************************************************************
+ * GET /api/v1/initiatives/:cid
+ **********************************************************************************************************************/
+
+func (c *InitiativesClient) Get(ctx context.Context, cid string) (Initiative, error) {
+	initiative := Initiative{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Get(Route("initiatives", cid)), &initiative, &apiError)
+	if err != nil {
+		return initiative, fmt.Errorf("failed getting initiative: %w", err)
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return initiative, fmt.Errorf("failed getting initiative: %w", err)
+	}
+	return initiative, nil
+}
+
+/***********************************************************************************************************************
+ * POST /api/v1/initiatives
+ **********************************************************************************************************************/
+
+type CreateInitiativeRequest struct {
+	Name                 string                          `json:"name"`
+	Description          string                          `json:"description,omitempty"`
+	ScorecardTag         string                          `json:"scorecardTag"`
+	TargetDate           string                          `json:"targetDate"`
+	IsDraft              bool                            `json:"isDraft"`
+	EmphasizedLevels     []InitiativeLevel               `json:"emphasizedLevels"`
+	EmphasizedRules      []InitiativeRule                `json:"emphasizedRules"`
+	Filter               *ScorecardFilter                `json:"filter,omitempty"`
+	NotificationSchedule *InitiativeNotificationSchedule `json:"notificationSchedule,omitempty"`
+}
+
+func (r *Initiative) ToCreateRequest() CreateInitiativeRequest {
+	return CreateInitiativeRequest{
+		Name:                 r.Name,
+		Description:          r.Description,
+		ScorecardTag:         r.ScorecardTag,
+		TargetDate:           r.TargetDate,
+		IsDraft:              r.IsDraft,
+		EmphasizedLevels:     r.EmphasizedLevels,
+		EmphasizedRules:      r.EmphasizedRules,
+		Filter:               r.Filter,
+		NotificationSchedule: r.NotificationSchedule,
+	}
+}
+
+func (c *InitiativesClient) Create(ctx context.Context, req CreateInitiativeRequest) (Initiative, error) {
+	initiative := Initiative{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Post(Route("initiatives", "")).BodyJSON(&req), &initiative, &apiError)
+	if err != nil {
+		return initiative, fmt.Errorf("failed creating initiative: %+v", err)
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return initiative, err
+	}
+
+	return initiative, nil
+}
+
+/***********************************************************************************************************************
+ * PUT /api/v1/initiatives/:cid
+ **********************************************************************************************************************/
+
+// UpdateInitiativeRequest replaces every field of the initiative, so it takes the same body as creation.
+type UpdateInitiativeRequest CreateInitiativeRequest
+
+func (r *Initiative) ToUpdateRequest() UpdateInitiativeRequest {
+	return UpdateInitiativeRequest(r.ToCreateRequest())
+}
+
+func (c *InitiativesClient) Update(ctx context.Context, cid string, req UpdateInitiativeRequest) (Initiative, error) {
+	initiative := Initiative{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Put(Route("initiatives", cid)).BodyJSON(&req), &initiative, &apiError)
+	if err != nil {
+		return initiative, errors.New("could not update initiative: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return initiative, err
+	}
+
+	return initiative, nil
+}
+
+/***********************************************************************************************************************
+ * DELETE /api/v1/initiatives/:cid - Delete an initiative
+ **********************************************************************************************************************/
+
+type DeleteInitiativeResponse struct{}
+
+func (c *InitiativesClient) Delete(ctx context.Context, cid string) error {
+	response := DeleteInitiativeResponse{}
+	apiError := ApiError{}
+
+	body, err := c.client.receive(ctx, c.Client().Delete(Route("initiatives", cid)), &response, &apiError)
+	if err != nil {
+		return errors.New("could not delete initiative: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(body, &apiError)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package cortex_test
+
+import (
+	"context"
+	"errors"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+var testInitiativeResponse = &cortex.Initiative{
+	Cid:              "test-initiative-cid",
+	Name:             "Q3 Production Readiness",
+	Description:      "Get all services to Gold",
+	ScorecardTag:     "production-readiness",
+	TargetDate:       "2026-09-30",
+	EmphasizedLevels: []cortex.InitiativeLevel{{LevelName: "Gold"}},
+	EmphasizedRules:  []cortex.InitiativeRule{{Expression: "git != null"}},
+}
+
+func TestGetInitiative(t *testing.T) {
+	cid := "test-initiative-cid"
+	c, teardown, err := setupClient(cortex.Route("initiatives", cid), testInitiativeResponse, AssertRequestMethod(t, "GET"))
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.Initiatives().Get(context.Background(), cid)
+	assert.Nil(t, err, "error retrieving an initiative")
+	assert.Equal(t, testInitiativeResponse.Cid, res.Cid)
+	assert.Equal(t, testInitiativeResponse.EmphasizedLevels, res.EmphasizedLevels)
+}
+
+func TestGetInitiativeNotFound(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc(cortex.Route("initiatives", "missing"), func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"type":"NOT_FOUND","message":"Initiative not found"}`))
+	})
+	c, teardown, err := buildClient(mux)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	_, err = c.Initiatives().Get(context.Background(), "missing")
+	assert.True(t, errors.Is(err, cortex.ApiErrorNotFound), "expected not found, got %v", err)
+}
+
+func TestCreateInitiative(t *testing.T) {
+	req := testInitiativeResponse.ToCreateRequest()
+	c, teardown, err := setupClient(
+		cortex.Route("initiatives", ""),
+		testInitiativeResponse,
+		AssertRequestMethod(t, "POST"),
+		AssertRequestBody(t, req),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.Initiatives().Create(context.Background(), req)
+	assert.Nil(t, err, "error creating an initiative")
+	assert.Equal(t, testInitiativeResponse.Cid, res.Cid)
+}
+
+func TestUpdateInitiative(t *testing.T) {
+	cid := "test-initiative-cid"
+	req := testInitiativeResponse.ToUpdateRequest()
+	c, teardown, err := setupClient(
+		cortex.Route("initiatives", cid),
+		testInitiativeResponse,
+		AssertRequestMethod(t, "PUT"),
+		AssertRequestBody(t, req),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.Initiatives().Update(context.Background(), cid, req)
+	assert.Nil(t, err, "error updating an initiative")
+	assert.Equal(t, cid, res.Cid)
+}
+
+func TestDeleteInitiative(t *testing.T) {
+	cid := "test-initiative-cid"
+	c, teardown, err := setupClient(
+		cortex.Route("initiatives", cid),
+		cortex.DeleteInitiativeResponse{},
+		AssertRequestMethod(t, "DELETE"),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	err = c.Initiatives().Delete(context.Background(), cid)
+	assert.Nil(t, err, "error deleting an initiative")
+}
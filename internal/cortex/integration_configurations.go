@@ -0,0 +1,150 @@
+package cortex
+
+import (
+	"context"
+	"errors"
+	"github.com/dghubble/sling"
+)
+
+// IntegrationConfigurationsClientInterface manages the tenant-side accounts of integrations, such as PagerDuty or
+// Datadog, that catalog entities refer to. Configurations are identified by alias, and their fields vary by
+// integration, so they are passed around as maps.
+type IntegrationConfigurationsClientInterface interface {
+	Get(ctx context.Context, integration string, alias string) (IntegrationConfiguration, error)
+	Create(ctx context.Context, integration string, config IntegrationConfiguration) (IntegrationConfiguration, error)
+	Update(ctx context.Context, integration string, alias string, config IntegrationConfiguration) (IntegrationConfiguration, error)
+	Delete(ctx context.Context, integration string, alias string) error
+}
+
+type IntegrationConfigurationsClient struct {
+	client *HttpClient
+}
+
+var _ IntegrationConfigurationsClientInterface = &IntegrationConfigurationsClient{}
+
+func (c *IntegrationConfigurationsClient) Client() *sling.Sling {
+	return c.client.Client()
+}
+
+/***********************************************************************************************************************
+ * Types
+ **********************************************************************************************************************/
+
+// Integrations with configurations, as the path of their configurations endpoint under /api/v1/.
+const (
+	IntegrationPagerDuty = "pagerduty/configurations"
+	IntegrationDatadog   = "datadog/configurations"
+	IntegrationGithubApp = "github/configurations/app"
+)
+
+// IntegrationConfiguration is the configuration of a single integration account. Secrets are write-only, and are
+// never returned by the API.
+type IntegrationConfiguration map[string]interface{}
+
+func (c IntegrationConfiguration) Alias() string {
+	return c.String("alias")
+}
+
+func (c IntegrationConfiguration) IsDefault() bool {
+	return c.Bool("isDefault")
+}
+
+// String returns the string value of a field, or "" if it is missing or not a string.
+func (c IntegrationConfiguration) String(key string) string {
+	value, _ := c[key].(string)
+	return value
+}
+
+// Bool returns the boolean value of a field, or false if it is missing or not a boolean.
+func (c IntegrationConfiguration) Bool(key string) bool {
+	value, _ := c[key].(bool)
+	return value
+}
+
+func (c *IntegrationConfigurationsClient) route(integration string, alias string) string {
+	if alias == "" {
+		return Route("integrations", integration)
+	}
+	return Route("integrations", integration+"/"+alias)
+}
+
+/***********************************************************************************************************************
+ * GET /api/v1/:integration/configurations/:alias
+ **********************************************************************************************************************/
+
+func (c *IntegrationConfigurationsClient) Get(ctx context.Context, integration string, alias string) (IntegrationConfiguration, error) {
+	config := IntegrationConfiguration{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Get(c.route(integration, alias)), &config, &apiError)
+	if err != nil {
+		return nil, errors.New("could not get integration configuration: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+/***********************************************************************************************************************
+ * POST /api/v1/:integration/configurations
+ **********************************************************************************************************************/
+
+func (c *IntegrationConfigurationsClient) Create(ctx context.Context, integration string, config IntegrationConfiguration) (IntegrationConfiguration, error) {
+	created := IntegrationConfiguration{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Post(c.route(integration, "")).BodyJSON(config), &created, &apiError)
+	if err != nil {
+		return nil, errors.New("could not create integration configuration: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+/***********************************************************************************************************************
+ * PUT /api/v1/:integration/configurations/:alias
+ **********************************************************************************************************************/
+
+func (c *IntegrationConfigurationsClient) Update(ctx context.Context, integration string, alias string, config IntegrationConfiguration) (IntegrationConfiguration, error) {
+	updated := IntegrationConfiguration{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Put(c.route(integration, alias)).BodyJSON(config), &updated, &apiError)
+	if err != nil {
+		return nil, errors.New("could not update integration configuration: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+/***********************************************************************************************************************
+ * DELETE /api/v1/:integration/configurations/:alias
+ **********************************************************************************************************************/
+
+type DeleteIntegrationConfigurationResponse struct{}
+
+func (c *IntegrationConfigurationsClient) Delete(ctx context.Context, integration string, alias string) error {
+	deleteResponse := DeleteIntegrationConfigurationResponse{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Delete(c.route(integration, alias)), &deleteResponse, &apiError)
+	if err != nil {
+		return errors.New("could not delete integration configuration: " + err.Error())
+	}
+
+	return c.client.handleResponseStatus(response, &apiError)
+}
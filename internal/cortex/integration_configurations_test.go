@@ -0,0 +1,97 @@
+package cortex_test
+
+import (
+	"bytes"
+	"context"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var testIntegrationConfiguration = cortex.IntegrationConfiguration{
+	"alias":     "production",
+	"isDefault": true,
+	"region":    "US1",
+}
+
+func TestGetIntegrationConfiguration(t *testing.T) {
+	c, teardown, err := setupClient(
+		cortex.Route("integrations", "datadog/configurations/production"),
+		testIntegrationConfiguration,
+		AssertRequestMethod(t, "GET"),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.IntegrationConfigurations().Get(context.Background(), cortex.IntegrationDatadog, "production")
+	assert.Nil(t, err, "error retrieving an integration configuration")
+	assert.Equal(t, testIntegrationConfiguration, res)
+	assert.Equal(t, "production", res.Alias())
+	assert.True(t, res.IsDefault())
+	assert.Equal(t, "", res.String("customSubdomain"))
+}
+
+func TestCreateIntegrationConfiguration(t *testing.T) {
+	req := cortex.IntegrationConfiguration{
+		"alias":     "production",
+		"isDefault": true,
+		"region":    "US1",
+		"apiKey":    "api-key",
+		"appKey":    "app-key",
+	}
+	c, teardown, err := setupClient(
+		cortex.Route("integrations", "datadog/configurations"),
+		testIntegrationConfiguration,
+		AssertRequestMethod(t, "POST"),
+		AssertRequestBody(t, req),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	res, err := c.IntegrationConfigurations().Create(ctx, cortex.IntegrationDatadog, req)
+	assert.Nil(t, err, "error creating an integration configuration")
+	assert.Equal(t, testIntegrationConfiguration, res)
+	assert.Contains(t, output.String(), "Cortex API request body")
+	assert.NotContains(t, output.String(), "app-key", "integration secrets must not be logged")
+}
+
+func TestUpdateIntegrationConfiguration(t *testing.T) {
+	req := cortex.IntegrationConfiguration{
+		"alias":        "github-app",
+		"isDefault":    false,
+		"appId":        "12345",
+		"clientId":     "Iv1.abc",
+		"clientSecret": "client-secret",
+		"privateKey":   "private-key",
+	}
+	c, teardown, err := setupClient(
+		cortex.Route("integrations", "github/configurations/app/github-app"),
+		cortex.IntegrationConfiguration{"alias": "github-app", "isDefault": false, "appId": "12345", "clientId": "Iv1.abc"},
+		AssertRequestMethod(t, "PUT"),
+		AssertRequestBody(t, req),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.IntegrationConfigurations().Update(context.Background(), cortex.IntegrationGithubApp, "github-app", req)
+	assert.Nil(t, err, "error updating an integration configuration")
+	assert.Equal(t, "github-app", res.Alias())
+	assert.NotContains(t, res, "clientSecret")
+}
+
+func TestDeleteIntegrationConfiguration(t *testing.T) {
+	c, teardown, err := setupClient(
+		cortex.Route("integrations", "pagerduty/configurations/production"),
+		cortex.DeleteIntegrationConfigurationResponse{},
+		AssertRequestMethod(t, "DELETE"),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	err = c.IntegrationConfigurations().Delete(context.Background(), cortex.IntegrationPagerDuty, "production")
+	assert.Nil(t, err, "error deleting an integration configuration")
+}
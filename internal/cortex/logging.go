@@ -0,0 +1,302 @@
+package cortex
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// LogSubsystem is the tflog subsystem that all Cortex API request/response logs are emitted on. Its level follows
+	// TF_LOG_PROVIDER unless overridden with LogSubsystemLevelEnvVar.
+	LogSubsystem = "cortex_http"
+
+	// LogSubsystemLevelEnvVar overrides the log level of LogSubsystem, e.g. TF_LOG_PROVIDER_CORTEX_HTTP=TRACE.
+	LogSubsystemLevelEnvVar = "TF_LOG_PROVIDER_CORTEX_HTTP"
+
+	// RequestIdHeader is the response header the Cortex API uses to identify a request.
+	RequestIdHeader = "X-Request-Id"
+
+	redactedValue = "***"
+)
+
+// sensitiveBodyKeys are JSON/YAML keys whose values are replaced with redactedValue before a body is logged.
+var sensitiveBodyKeys = []string{
+	"token",
+	"apikey",
+	"api_key",
+	"appkey",
+	"app_key",
+	"clientsecret",
+	"client_secret",
+	"secret",
+	"password",
+	"privatekey",
+	"private_key",
+	"authorization",
+}
+
+// sensitiveBodyMapKeys are JSON/YAML keys holding maps whose values are all replaced with redactedValue before a body
+// is logged, such as the headers of a workflow HTTP request action, which may be named anything.
+var sensitiveBodyMapKeys = []string{
+	"headers",
+}
+
+// newLogContext returns ctx with the Cortex HTTP logging subsystem configured, masking the Authorization header and
+// the API token anywhere they would otherwise appear.
+func newLogContext(ctx context.Context, token string) context.Context {
+	ctx = tflog.NewSubsystem(ctx, LogSubsystem, tflog.WithLevelFromEnv(LogSubsystemLevelEnvVar))
+	ctx = tflog.SubsystemMaskFieldValuesWithFieldKeys(ctx, LogSubsystem, "Authorization")
+	if token != "" {
+		ctx = tflog.SubsystemMaskAllFieldValuesStrings(ctx, LogSubsystem, token)
+		ctx = tflog.SubsystemMaskMessageStrings(ctx, LogSubsystem, token)
+	}
+	return ctx
+}
+
+// requestLogKey is the context key of a requestLog.
+type requestLogKey struct{}
+
+// requestLog is a message about a request that is logged by the logging transport when the request is sent.
+type requestLog struct {
+	message string
+	fields  map[string]interface{}
+}
+
+// withRequestLog returns ctx carrying a message to log when a request made with ctx is sent. Logging it from the
+// transport masks it with the token already on the request, instead of fetching the token again.
+func withRequestLog(ctx context.Context, message string, fields map[string]interface{}) context.Context {
+	return context.WithValue(ctx, requestLogKey{}, requestLog{message: message, fields: fields})
+}
+
+// responseLogContext returns ctx prepared for logging about a response, masking the token its request was sent with.
+func responseLogContext(ctx context.Context, response *http.Response) context.Context {
+	token := ""
+	if response != nil && response.Request != nil {
+		token = bearerToken(response.Request)
+	}
+	return newLogContext(ctx, token)
+}
+
+// bearerToken returns the token sent in the request's Authorization header, if any.
+func bearerToken(req *http.Request) string {
+	return strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")
+}
+
+// redactHeaders returns a flattened copy of the headers that is safe to log.
+func redactHeaders(header http.Header) map[string]string {
+	headers := make(map[string]string, len(header))
+	for k, v := range header {
+		if strings.EqualFold(k, "Authorization") {
+			headers[k] = redactedValue
+			continue
+		}
+		headers[k] = strings.Join(v, ", ")
+	}
+	return headers
+}
+
+// redactBody returns a copy of body that is safe to log, choosing how to redact it by its Content-Type. Bodies that
+// can't be redacted aren't logged at all, since the token masking configured in newLogContext only hides the API
+// token and not any other secrets they may contain.
+func redactBody(contentType string, body []byte) (string, bool) {
+	if isYamlContentType(contentType) {
+		return redactYaml(string(body)), true
+	}
+	return redactJson(body)
+}
+
+// isYamlContentType returns true for the content types that the Cortex API uses for YAML descriptors.
+func isYamlContentType(contentType string) bool {
+	ct := strings.ToLower(contentType)
+	return strings.Contains(ct, "yaml") || strings.Contains(ct, "openapi")
+}
+
+// redactJson replaces the values of sensitive keys in a JSON body, returning false if the body isn't JSON.
+func redactJson(body []byte) (string, bool) {
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return "", false
+	}
+	switch v.(type) {
+	case map[string]interface{}, []interface{}:
+	default:
+		return string(body), true
+	}
+	out, err := json.Marshal(redactValue(v))
+	if err != nil {
+		return "", false
+	}
+	return string(out), true
+}
+
+func redactValue(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, val := range t {
+			if isSensitiveKey(k) {
+				t[k] = redactedValue
+				continue
+			}
+			if m, ok := val.(map[string]interface{}); ok && isSensitiveMapKey(k) {
+				for mk := range m {
+					m[mk] = redactedValue
+				}
+				continue
+			}
+			t[k] = redactValue(val)
+		}
+		return t
+	case []interface{}:
+		for i, val := range t {
+			t[i] = redactValue(val)
+		}
+		return t
+	default:
+		return v
+	}
+}
+
+func isSensitiveKey(key string) bool {
+	return containsKey(sensitiveBodyKeys, key)
+}
+
+func isSensitiveMapKey(key string) bool {
+	return containsKey(sensitiveBodyMapKeys, key)
+}
+
+func containsKey(keys []string, key string) bool {
+	k := strings.ToLower(key)
+	for _, s := range keys {
+		if k == s {
+			return true
+		}
+	}
+	return false
+}
+
+// redactYaml replaces the values of sensitive keys in a YAML descriptor line by line, so that descriptors can be
+// logged without re-encoding them. Every value nested under a sensitive map key is replaced, as are the lines of any
+// block nested under a replaced value.
+func redactYaml(body string) string {
+	lines := strings.Split(body, "\n")
+	mapIndent, blockIndent := -1, -1
+	for i, line := range lines {
+		trimmed := strings.TrimLeft(line, " -")
+		if strings.TrimSpace(trimmed) == "" {
+			continue
+		}
+		indent := len(line) - len(trimmed)
+		if blockIndent >= 0 {
+			if indent > blockIndent {
+				lines[i] = line[:indent] + redactedValue
+				continue
+			}
+			blockIndent = -1
+		}
+		if mapIndent >= 0 && indent <= mapIndent {
+			mapIndent = -1
+		}
+
+		key, value, found := strings.Cut(trimmed, ":")
+		if !found {
+			continue
+		}
+		name := strings.Trim(key, `"'`)
+		value = strings.TrimSpace(value)
+		if isSensitiveMapKey(name) && mapIndent < 0 && value == "" {
+			mapIndent = indent
+			continue
+		}
+		if mapIndent >= 0 || isSensitiveKey(name) || isSensitiveMapKey(name) {
+			lines[i] = line[:indent] + key + ": " + redactedValue
+			if value == "" || strings.HasPrefix(value, "|") || strings.HasPrefix(value, ">") {
+				blockIndent = indent
+			}
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+/***********************************************************************************************************************
+ * Transport
+ **********************************************************************************************************************/
+
+// loggingTransport is an http.RoundTripper that logs every Cortex API request and response through tflog, so that
+// HTTP tracing respects TF_LOG_PROVIDER and ends up in Terraform's log output.
+type loggingTransport struct {
+	transport http.RoundTripper
+}
+
+var _ http.RoundTripper = &loggingTransport{}
+
+func newLoggingTransport(transport http.RoundTripper) *loggingTransport {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	return &loggingTransport{transport: transport}
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := newLogContext(req.Context(), bearerToken(req))
+	l, hasRequestLog := req.Context().Value(requestLogKey{}).(requestLog)
+	if hasRequestLog {
+		tflog.SubsystemDebug(ctx, LogSubsystem, l.message, l.fields)
+	}
+
+	fields := map[string]interface{}{
+		"http_method": req.Method,
+		"http_url":    req.URL.String(),
+	}
+	tflog.SubsystemDebug(ctx, LogSubsystem, "Sending Cortex API request", fields, map[string]interface{}{
+		"http_req_headers": redactHeaders(req.Header),
+	})
+	// Requests that carry their own log message have already logged their (redacted) body with it.
+	if req.Body != nil && req.GetBody != nil && !hasRequestLog {
+		if body, err := req.GetBody(); err == nil {
+			b, _ := io.ReadAll(body)
+			_ = body.Close()
+			if redacted, ok := redactBody(req.Header.Get("Content-Type"), b); ok {
+				tflog.SubsystemTrace(ctx, LogSubsystem, "Cortex API request body", fields, map[string]interface{}{
+					"http_req_body": redacted,
+				})
+			}
+		}
+	}
+
+	start := time.Now()
+	resp, err := t.transport.RoundTrip(req)
+	fields["http_duration_ms"] = time.Since(start).Milliseconds()
+	if err != nil {
+		tflog.SubsystemError(ctx, LogSubsystem, "Cortex API request failed", fields, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return resp, err
+	}
+
+	fields["http_status"] = resp.StatusCode
+	fields["http_request_id"] = resp.Header.Get(RequestIdHeader)
+	tflog.SubsystemDebug(ctx, LogSubsystem, "Received Cortex API response", fields)
+
+	if resp.Body != nil {
+		b, readErr := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		if readErr != nil {
+			return nil, readErr
+		}
+		resp.Body = io.NopCloser(bytes.NewReader(b))
+		if redacted, ok := redactBody(resp.Header.Get("Content-Type"), b); ok {
+			tflog.SubsystemTrace(ctx, LogSubsystem, "Cortex API response body", fields, map[string]interface{}{
+				"http_resp_body": redacted,
+			})
+		}
+	}
+
+	return resp, nil
+}
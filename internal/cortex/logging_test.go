@@ -0,0 +1,189 @@
+package cortex_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoggingTransport(t *testing.T) {
+	resp := cortex.ResourceDefinition{
+		Type:        "test-definition",
+		Description: "created with token test",
+		Schema: map[string]interface{}{
+			"password": "hunter2",
+		},
+	}
+	c, teardown, err := setupClient(cortex.Route("resource_definitions", ""), resp)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	_, err = c.ResourceDefinitions().Create(ctx, cortex.CreateResourceDefinitionRequest{
+		Type:        resp.Type,
+		Description: resp.Description,
+		Schema:      resp.Schema,
+	})
+	assert.Nil(t, err, "error creating a resource definition")
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	assert.Nil(t, err, "could not decode log output")
+
+	messages := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		assert.Equal(t, "provider."+cortex.LogSubsystem, entry["@module"])
+		messages = append(messages, entry["@message"].(string))
+	}
+	assert.Equal(t, []string{
+		"Sending Cortex API request",
+		"Cortex API request body",
+		"Received Cortex API response",
+		"Cortex API response body",
+	}, messages)
+
+	response := entries[2]
+	assert.Equal(t, "POST", response["http_method"])
+	assert.Equal(t, float64(200), response["http_status"])
+	assert.Contains(t, response, "http_duration_ms")
+	assert.Contains(t, response, "http_request_id")
+
+	raw := output.String()
+	assert.NotContains(t, raw, "Bearer", "authorization header must not be logged")
+	assert.NotContains(t, raw, "hunter2", "sensitive body values must not be logged")
+	assert.NotContains(t, raw, "with token test", "the API token must not be logged")
+}
+
+// countingTokenSource returns a fixed token, counting how often it is asked for one.
+type countingTokenSource struct {
+	token string
+	calls int
+}
+
+func (s *countingTokenSource) Token(ctx context.Context) (string, error) {
+	s.calls++
+	return s.token, nil
+}
+
+func TestLoggingDescriptorMasksRequestToken(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	tokenSource := &countingTokenSource{token: "descriptor-token"}
+	c, err := cortex.NewClient(cortex.WithURL(ts.URL), cortex.WithTokenSource(tokenSource))
+	assert.Nil(t, err, "could not build client")
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	err = c.Workflows().UpsertDescriptor(ctx, "tag: test\ndescription: uses descriptor-token\n")
+	assert.Nil(t, err, "error upserting a workflow descriptor")
+	assert.Equal(t, 1, tokenSource.calls, "the token must only be fetched to authorize the request")
+
+	raw := output.String()
+	assert.Contains(t, raw, "Upserting workflow descriptor")
+	assert.NotContains(t, raw, "descriptor-token", "the API token must not be logged")
+}
+
+func TestLoggingRedactsYamlBodies(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+	}{
+		{name: "yaml content type", contentType: "application/yaml"},
+		{name: "unknown content type", contentType: "text/plain"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				if req.Method == http.MethodPost {
+					w.Header().Set("Content-Type", "application/json")
+					_, _ = w.Write([]byte(`{}`))
+					return
+				}
+				w.Header().Set("Content-Type", tt.contentType)
+				_, _ = w.Write([]byte("tag: test\nname: Test\nsecret: response-secret\n"))
+			}))
+			defer ts.Close()
+
+			c, err := cortex.NewClient(cortex.WithURL(ts.URL), cortex.WithToken("test"))
+			assert.Nil(t, err, "could not build client")
+
+			var output bytes.Buffer
+			ctx := tflogtest.RootLogger(context.Background(), &output)
+
+			err = c.Workflows().UpsertDescriptor(ctx, "tag: test\nname: Test\npassword: request-secret\n")
+			assert.Nil(t, err, "error upserting a workflow descriptor")
+			_, err = c.Workflows().Get(ctx, "test")
+			assert.Nil(t, err, "error getting a workflow")
+
+			raw := output.String()
+			assert.Equal(t, 1, bytes.Count(output.Bytes(), []byte("password: ***")), "the descriptor must be logged once")
+			assert.NotContains(t, raw, "request-secret", "sensitive request body values must not be logged")
+			assert.NotContains(t, raw, "response-secret", "sensitive response body values must not be logged")
+		})
+	}
+}
+
+func TestLoggingRedactsWorkflowHeaders(t *testing.T) {
+	workflow := cortex.Workflow{
+		Tag:    "test-workflow",
+		Name:   "Test Workflow",
+		Filter: cortex.WorkflowFilter{Type: cortex.WorkflowFilterTypeGlobal},
+		Actions: []cortex.WorkflowAction{
+			{
+				Name: "Call Service",
+				Slug: "call-service",
+				Schema: map[string]interface{}{
+					"type":       cortex.WorkflowActionTypeHttpRequest,
+					"httpMethod": "POST",
+					"url":        "https://example.com/hook",
+					"headers": map[string]interface{}{
+						"Authorization": "Bearer header-secret",
+						"X-Api-Key":     "api-key-secret",
+					},
+				},
+				OutgoingActions: []string{},
+				IsRootAction:    true,
+			},
+		},
+	}
+	descriptor, err := workflow.ToYaml()
+	assert.Nil(t, err, "could not marshal workflow")
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method == http.MethodPost {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/yaml")
+		_, _ = w.Write([]byte(descriptor))
+	}))
+	defer ts.Close()
+
+	c, err := cortex.NewClient(cortex.WithURL(ts.URL), cortex.WithToken("test"))
+	assert.Nil(t, err, "could not build client")
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	_, err = c.Workflows().Upsert(ctx, workflow)
+	assert.Nil(t, err, "error upserting a workflow")
+	_, err = c.Workflows().GetDescriptor(ctx, workflow.Tag)
+	assert.Nil(t, err, "error getting a workflow descriptor")
+
+	raw := output.String()
+	assert.Contains(t, raw, "https://example.com/hook", "other action fields should still be logged")
+	assert.NotContains(t, raw, "header-secret", "workflow header values must not be logged")
+	assert.NotContains(t, raw, "api-key-secret", "workflow header values must not be logged")
+}
@@ -0,0 +1,59 @@
+package cortex
+
+import (
+	"context"
+	"errors"
+	"github.com/dghubble/sling"
+)
+
+type PermissionsClientInterface interface {
+	List(ctx context.Context) (PermissionsResponse, error)
+}
+
+type PermissionsClient struct {
+	client *HttpClient
+}
+
+var _ PermissionsClientInterface = &PermissionsClient{}
+
+func (c *PermissionsClient) Client() *sling.Sling {
+	return c.client.Client()
+}
+
+/***********************************************************************************************************************
+ * Types
+ **********************************************************************************************************************/
+
+// Permission is a permission that can be granted by a role. Identifier is the value used in Role.Permissions.
+type Permission struct {
+	Identifier  string `json:"identifier"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Category    string `json:"category,omitempty"`
+}
+
+/***********************************************************************************************************************
+ * GET /api/v1/permissions
+ **********************************************************************************************************************/
+
+// PermissionsResponse is the response from the GET /v1/permissions endpoint.
+type PermissionsResponse struct {
+	Permissions []Permission `json:"permissions"`
+}
+
+func (c *PermissionsClient) List(ctx context.Context) (PermissionsResponse, error) {
+	data := PermissionsResponse{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Get(Route("permissions", "")), &data, &apiError)
+	if err != nil {
+		return data, errors.New("could not get permissions: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return data, err
+	}
+
+	return data, nil
+}
@@ -0,0 +1,24 @@
+package cortex_test
+
+import (
+	"context"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestListPermissions(t *testing.T) {
+	resp := cortex.PermissionsResponse{
+		Permissions: []cortex.Permission{
+			{Identifier: "VIEW_CATALOG", Name: "View catalog", Category: "Catalog"},
+			{Identifier: "EDIT_DEPLOYS", Name: "Edit deploys", Description: "Record and delete deploys", Category: "Catalog"},
+		},
+	}
+	c, teardown, err := setupClient(cortex.Route("permissions", ""), resp, AssertRequestMethod(t, "GET"))
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.Permissions().List(context.Background())
+	assert.Nil(t, err, "error listing permissions")
+	assert.Equal(t, resp, res)
+}
@@ -0,0 +1,163 @@
+package cortex
+
+import (
+	"context"
+	"errors"
+	"github.com/dghubble/sling"
+)
+
+type RelationshipTypesClientInterface interface {
+	Get(ctx context.Context, tag string) (RelationshipType, error)
+	Create(ctx context.Context, req UpsertRelationshipTypeRequest) (RelationshipType, error)
+	Update(ctx context.Context, tag string, req UpsertRelationshipTypeRequest) (RelationshipType, error)
+	Delete(ctx context.Context, tag string) error
+}
+
+type RelationshipTypesClient struct {
+	client *HttpClient
+}
+
+var _ RelationshipTypesClientInterface = &RelationshipTypesClient{}
+
+func (c *RelationshipTypesClient) Client() *sling.Sling {
+	return c.client.Client()
+}
+
+/***********************************************************************************************************************
+ * Types
+ **********************************************************************************************************************/
+
+const (
+	RelationshipTypeDefinitionLocationSource      = "SOURCE"
+	RelationshipTypeDefinitionLocationDestination = "DESTINATION"
+	RelationshipTypeDefinitionLocationBoth        = "BOTH"
+
+	RelationshipTypeInheritanceNone     = "NONE"
+	RelationshipTypeInheritanceAppend   = "APPEND"
+	RelationshipTypeInheritanceFallback = "FALLBACK"
+)
+
+// RelationshipType is a user-defined kind of edge between catalog entities, such as "runs-on" or
+// "owned-by-cost-center". Relationships are directed from a source entity to a destination entity.
+type RelationshipType struct {
+	Tag                 string                        `json:"tag"`
+	Name                string                        `json:"name"`
+	Description         string                        `json:"description,omitempty"`
+	DefinitionLocation  string                        `json:"definitionLocation,omitempty"`
+	AllowCycles         bool                          `json:"allowCycles"`
+	IsSingleSource      bool                          `json:"isSingleSource"`
+	IsSingleDestination bool                          `json:"isSingleDestination"`
+	SourcesFilter       RelationshipTypeEntityFilter  `json:"sourcesFilter"`
+	DestinationsFilter  RelationshipTypeEntityFilter  `json:"destinationsFilter"`
+	Inheritances        []RelationshipTypeInheritance `json:"inheritances"`
+}
+
+// RelationshipTypeEntityFilter restricts which entity types may be on one side of a relationship. An empty list of
+// types allows every entity type.
+type RelationshipTypeEntityFilter struct {
+	Types []string `json:"types"`
+}
+
+// RelationshipTypeInheritance controls how a destination inherits a field, such as owners, from its sources.
+type RelationshipTypeInheritance struct {
+	Tag             string `json:"tag"`
+	InheritanceType string `json:"inheritanceType"`
+}
+
+/***********************************************************************************************************************
+ * GET /api/v1/relationship-types/:tag
+ **********************************************************************************************************************/
+
+func (c *RelationshipTypesClient) Get(ctx context.Context, tag string) (RelationshipType, error) {
+	relationshipType := RelationshipType{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Get(Route("relationship_types", tag)), &relationshipType, &apiError)
+	if err != nil {
+		return relationshipType, errors.New("could not get relationship type: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return relationshipType, err
+	}
+
+	return relationshipType, nil
+}
+
+/***********************************************************************************************************************
+ * POST /api/v1/relationship-types
+ **********************************************************************************************************************/
+
+type UpsertRelationshipTypeRequest struct {
+	Tag                 string                        `json:"tag"`
+	Name                string                        `json:"name"`
+	Description         string                        `json:"description,omitempty"`
+	DefinitionLocation  string                        `json:"definitionLocation,omitempty"`
+	AllowCycles         bool                          `json:"allowCycles"`
+	IsSingleSource      bool                          `json:"isSingleSource"`
+	IsSingleDestination bool                          `json:"isSingleDestination"`
+	SourcesFilter       RelationshipTypeEntityFilter  `json:"sourcesFilter"`
+	DestinationsFilter  RelationshipTypeEntityFilter  `json:"destinationsFilter"`
+	Inheritances        []RelationshipTypeInheritance `json:"inheritances"`
+}
+
+func (r *RelationshipType) ToUpsertRequest() UpsertRelationshipTypeRequest {
+	return UpsertRelationshipTypeRequest(*r)
+}
+
+func (c *RelationshipTypesClient) Create(ctx context.Context, req UpsertRelationshipTypeRequest) (RelationshipType, error) {
+	relationshipType := RelationshipType{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Post(Route("relationship_types", "")).BodyJSON(&req), &relationshipType, &apiError)
+	if err != nil {
+		return relationshipType, errors.New("could not create relationship type: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return relationshipType, err
+	}
+
+	return relationshipType, nil
+}
+
+/***********************************************************************************************************************
+ * PUT /api/v1/relationship-types/:tag
+ **********************************************************************************************************************/
+
+func (c *RelationshipTypesClient) Update(ctx context.Context, tag string, req UpsertRelationshipTypeRequest) (RelationshipType, error) {
+	relationshipType := RelationshipType{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Put(Route("relationship_types", tag)).BodyJSON(&req), &relationshipType, &apiError)
+	if err != nil {
+		return relationshipType, errors.New("could not update relationship type: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return relationshipType, err
+	}
+
+	return relationshipType, nil
+}
+
+/***********************************************************************************************************************
+ * DELETE /api/v1/relationship-types/:tag
+ **********************************************************************************************************************/
+
+type DeleteRelationshipTypeResponse struct{}
+
+func (c *RelationshipTypesClient) Delete(ctx context.Context, tag string) error {
+	deleteResponse := DeleteRelationshipTypeResponse{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Delete(Route("relationship_types", tag)), &deleteResponse, &apiError)
+	if err != nil {
+		return errors.New("could not delete relationship type: " + err.Error())
+	}
+
+	return c.client.handleResponseStatus(response, &apiError)
+}
@@ -0,0 +1,82 @@
+package cortex_test
+
+import (
+	"context"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var testRelationshipType = cortex.RelationshipType{
+	Tag:                 "runs-on",
+	Name:                "Runs on",
+	Description:         "Services running on infrastructure",
+	DefinitionLocation:  cortex.RelationshipTypeDefinitionLocationSource,
+	IsSingleDestination: true,
+	SourcesFilter:       cortex.RelationshipTypeEntityFilter{Types: []string{"service"}},
+	DestinationsFilter:  cortex.RelationshipTypeEntityFilter{Types: []string{"cluster"}},
+	Inheritances: []cortex.RelationshipTypeInheritance{
+		{Tag: "owners", InheritanceType: cortex.RelationshipTypeInheritanceFallback},
+	},
+}
+
+func TestGetRelationshipType(t *testing.T) {
+	c, teardown, err := setupClient(
+		cortex.Route("relationship_types", testRelationshipType.Tag),
+		testRelationshipType,
+		AssertRequestMethod(t, "GET"),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.RelationshipTypes().Get(context.Background(), testRelationshipType.Tag)
+	assert.Nil(t, err, "error retrieving a relationship type")
+	assert.Equal(t, testRelationshipType, res)
+}
+
+func TestCreateRelationshipType(t *testing.T) {
+	req := testRelationshipType.ToUpsertRequest()
+	c, teardown, err := setupClient(
+		cortex.Route("relationship_types", ""),
+		testRelationshipType,
+		AssertRequestMethod(t, "POST"),
+		AssertRequestBody(t, req),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.RelationshipTypes().Create(context.Background(), req)
+	assert.Nil(t, err, "error creating a relationship type")
+	assert.Equal(t, testRelationshipType, res)
+}
+
+func TestUpdateRelationshipType(t *testing.T) {
+	updated := testRelationshipType
+	updated.AllowCycles = true
+	req := updated.ToUpsertRequest()
+	c, teardown, err := setupClient(
+		cortex.Route("relationship_types", testRelationshipType.Tag),
+		updated,
+		AssertRequestMethod(t, "PUT"),
+		AssertRequestBody(t, req),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.RelationshipTypes().Update(context.Background(), testRelationshipType.Tag, req)
+	assert.Nil(t, err, "error updating a relationship type")
+	assert.Equal(t, updated, res)
+}
+
+func TestDeleteRelationshipType(t *testing.T) {
+	c, teardown, err := setupClient(
+		cortex.Route("relationship_types", testRelationshipType.Tag),
+		cortex.DeleteRelationshipTypeResponse{},
+		AssertRequestMethod(t, "DELETE"),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	err = c.RelationshipTypes().Delete(context.Background(), testRelationshipType.Tag)
+	assert.Nil(t, err, "error deleting a relationship type")
+}
@@ -11,6 +11,8 @@ type ResourceDefinitionsClientInterface interface {
 	List(ctx context.Context, params *ResourceDefinitionListParams) (ResourceDefinitionsResponse, error)
 	Create(ctx context.Context, req CreateResourceDefinitionRequest) (ResourceDefinition, error)
 	Update(ctx context.Context, typeName string, req UpdateResourceDefinitionRequest) (ResourceDefinition, error)
+	CreateEntityType(ctx context.Context, req CreateEntityTypeRequest) (ResourceDefinition, error)
+	UpdateEntityType(ctx context.Context, typeName string, req UpdateEntityTypeRequest) (ResourceDefinition, error)
 	Delete(ctx context.Context, typeName string) error
 }
 
@@ -29,12 +31,18 @@ func (c *ResourceDefinitionsClient) Client() *sling.Sling {
  **********************************************************************************************************************/
 
 // ResourceDefinition is the response object that is typically returned from the resource definitions endpoints.
+// Custom entity types are also resource definitions; they additionally set DisplayName, IconTag, ParentType and
+// CanOwnEntities.
 type ResourceDefinition struct {
-	Type        string                 `json:"type" yaml:"type"`
-	Name        string                 `json:"name,omitempty" yaml:"name,omitempty"`
-	Description string                 `json:"description,omitempty" yaml:"description,omitempty"`
-	Schema      map[string]interface{} `json:"schema,omitempty" yaml:"schema,omitempty"`
-	Source      string                 `json:"source,omitempty" yaml:"source,omitempty"`
+	Type           string                 `json:"type" yaml:"type"`
+	Name           string                 `json:"name,omitempty" yaml:"name,omitempty"`
+	Description    string                 `json:"description,omitempty" yaml:"description,omitempty"`
+	Schema         map[string]interface{} `json:"schema,omitempty" yaml:"schema,omitempty"`
+	Source         string                 `json:"source,omitempty" yaml:"source,omitempty"`
+	DisplayName    string                 `json:"displayName,omitempty" yaml:"displayName,omitempty"`
+	IconTag        string                 `json:"iconTag,omitempty" yaml:"iconTag,omitempty"`
+	ParentType     string                 `json:"parentType,omitempty" yaml:"parentType,omitempty"`
+	CanOwnEntities bool                   `json:"canOwnEntities,omitempty" yaml:"canOwnEntities,omitempty"`
 }
 
 func (r *ResourceDefinition) SchemaAsString() (string, error) {
@@ -56,7 +64,7 @@ func (r *ResourceDefinition) SchemaAsString() (string, error) {
 func (c *ResourceDefinitionsClient) Get(ctx context.Context, typeName string) (ResourceDefinition, error) {
 	data := ResourceDefinition{}
 	apiError := ApiError{}
-	response, err := c.Client().Get(Route("resource_definitions", typeName)).Receive(&data, &apiError)
+	response, err := c.client.receive(ctx, c.Client().Get(Route("resource_definitions", typeName)), &data, &apiError)
 	if err != nil {
 		return data, errors.New("could not get resource definition: " + err.Error())
 	}
@@ -88,7 +96,7 @@ func (c *ResourceDefinitionsClient) List(ctx context.Context, params *ResourceDe
 	data := ResourceDefinitionsResponse{}
 	apiError := ApiError{}
 
-	response, err := c.Client().Get(Route("resource_definitions", "")).QueryStruct(&params).Receive(&data, &apiError)
+	response, err := c.client.receive(ctx, c.Client().Get(Route("resource_definitions", "")).QueryStruct(&params), &data, &apiError)
 	if err != nil {
 		return data, errors.New("could not get resource definitions: " + err.Error())
 	}
@@ -130,7 +138,7 @@ func (c *ResourceDefinitionsClient) Create(ctx context.Context, req CreateResour
 	data := ResourceDefinition{}
 	apiError := ApiError{}
 
-	response, err := c.Client().Post(Route("resource_definitions", "")).BodyJSON(&req).Receive(&data, &apiError)
+	response, err := c.client.receive(ctx, c.Client().Post(Route("resource_definitions", "")).BodyJSON(&req), &data, &apiError)
 	if err != nil {
 		return data, errors.New("could not create a resource definition: " + err.Error())
 	}
@@ -165,7 +173,7 @@ func (c *ResourceDefinitionsClient) Update(ctx context.Context, typeName string,
 	data := ResourceDefinition{}
 	apiError := ApiError{}
 
-	response, err := c.Client().Put(Route("resource_definitions", typeName)).BodyJSON(&req).Receive(&data, &apiError)
+	response, err := c.client.receive(ctx, c.Client().Put(Route("resource_definitions", typeName)).BodyJSON(&req), &data, &apiError)
 	if err != nil {
 		return data, errors.New("could not update a resource definition: " + err.Error())
 	}
@@ -178,6 +186,73 @@ func (c *ResourceDefinitionsClient) Update(ctx context.Context, typeName string,
 	return data, nil
 }
 
+/***********************************************************************************************************************
+ * POST /api/v1/catalog/definitions - Create a custom entity type
+ **********************************************************************************************************************/
+
+// CreateEntityTypeRequest creates a custom entity type, which is a resource definition with the entity type fields.
+// DisplayName, IconTag and ParentType are always sent, as null when they are nil.
+type CreateEntityTypeRequest struct {
+	Type           string                 `json:"type"`
+	Name           string                 `json:"name,omitempty"`
+	Description    string                 `json:"description,omitempty"`
+	Schema         map[string]interface{} `json:"schema,omitempty"`
+	DisplayName    *string                `json:"displayName"`
+	IconTag        *string                `json:"iconTag"`
+	ParentType     *string                `json:"parentType"`
+	CanOwnEntities bool                   `json:"canOwnEntities"`
+}
+
+func (c *ResourceDefinitionsClient) CreateEntityType(ctx context.Context, req CreateEntityTypeRequest) (ResourceDefinition, error) {
+	data := ResourceDefinition{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Post(Route("resource_definitions", "")).BodyJSON(&req), &data, &apiError)
+	if err != nil {
+		return data, errors.New("could not create an entity type: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return data, err
+	}
+
+	return data, nil
+}
+
+/***********************************************************************************************************************
+ * PUT /api/v1/catalog/definitions/:typeName - Update a custom entity type
+ **********************************************************************************************************************/
+
+// UpdateEntityTypeRequest updates a custom entity type. DisplayName, IconTag and ParentType are always sent, so that a
+// nil value clears them.
+type UpdateEntityTypeRequest struct {
+	Name           string                 `json:"name,omitempty"`
+	Description    string                 `json:"description,omitempty"`
+	Schema         map[string]interface{} `json:"schema,omitempty"`
+	DisplayName    *string                `json:"displayName"`
+	IconTag        *string                `json:"iconTag"`
+	ParentType     *string                `json:"parentType"`
+	CanOwnEntities bool                   `json:"canOwnEntities"`
+}
+
+func (c *ResourceDefinitionsClient) UpdateEntityType(ctx context.Context, typeName string, req UpdateEntityTypeRequest) (ResourceDefinition, error) {
+	data := ResourceDefinition{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Put(Route("resource_definitions", typeName)).BodyJSON(&req), &data, &apiError)
+	if err != nil {
+		return data, errors.New("could not update an entity type: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return data, err
+	}
+
+	return data, nil
+}
+
 /***********************************************************************************************************************
  * DELETE /api/v1/catalog/definitions/:typeName - Delete a resource definition
  **********************************************************************************************************************/
@@ -188,7 +263,7 @@ func (c *ResourceDefinitionsClient) Delete(ctx context.Context, typeName string)
 	deleteDefinitionResponse := DeleteResourceDefinitionResponse{}
 	apiError := ApiError{}
 
-	response, err := c.Client().Delete(Route("resource_definitions", typeName)).Receive(&deleteDefinitionResponse, &apiError)
+	response, err := c.client.receive(ctx, c.Client().Delete(Route("resource_definitions", typeName)), &deleteDefinitionResponse, &apiError)
 	if err != nil {
 		return errors.New("could not delete resource definition: " + err.Error())
 	}
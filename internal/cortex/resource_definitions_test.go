@@ -137,3 +137,79 @@ func TestDeleteResourceDefinition(t *testing.T) {
 	err = c.ResourceDefinitions().Delete(context.Background(), typeName)
 	assert.Nil(t, err, "error deleting a resource definition")
 }
+
+func TestCreateEntityType(t *testing.T) {
+	displayName := "Business Units"
+	iconTag := "building"
+	parentType := "domain"
+	req := cortex.CreateEntityTypeRequest{
+		Type:           "business-unit",
+		Name:           "Business Unit",
+		Schema:         map[string]interface{}{"type": "object"},
+		DisplayName:    &displayName,
+		IconTag:        &iconTag,
+		ParentType:     &parentType,
+		CanOwnEntities: true,
+	}
+	resp := &cortex.ResourceDefinition{
+		Type:           req.Type,
+		Name:           req.Name,
+		Schema:         req.Schema,
+		Source:         "CUSTOM",
+		DisplayName:    displayName,
+		IconTag:        iconTag,
+		ParentType:     parentType,
+		CanOwnEntities: true,
+	}
+	c, teardown, err := setupClient(
+		cortex.Route("resource_definitions", ""),
+		resp,
+		AssertRequestMethod(t, "POST"),
+		AssertRequestBody(t, req),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.ResourceDefinitions().CreateEntityType(context.Background(), req)
+	assert.Nil(t, err, "error creating an entity type")
+	assert.Equal(t, displayName, res.DisplayName)
+	assert.Equal(t, iconTag, res.IconTag)
+	assert.Equal(t, parentType, res.ParentType)
+	assert.True(t, res.CanOwnEntities)
+}
+
+func TestUpdateEntityTypeClearsFields(t *testing.T) {
+	iconTag := "building"
+	req := cortex.UpdateEntityTypeRequest{
+		Name:    "Business Unit",
+		IconTag: &iconTag,
+	}
+	c, teardown, err := setupClient(
+		cortex.Route("resource_definitions", "business-unit"),
+		&cortex.ResourceDefinition{Type: "business-unit", Name: req.Name, IconTag: iconTag},
+		AssertRequestMethod(t, "PUT"),
+		AssertRequestBodyString(t, `{"name":"Business Unit","displayName":null,"iconTag":"building","parentType":null,"canOwnEntities":false}`),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	_, err = c.ResourceDefinitions().UpdateEntityType(context.Background(), "business-unit", req)
+	assert.Nil(t, err, "error updating an entity type")
+}
+
+func TestUpdateResourceDefinitionOmitsEntityTypeFields(t *testing.T) {
+	req := cortex.UpdateResourceDefinitionRequest{
+		Name: "Business Unit",
+	}
+	c, teardown, err := setupClient(
+		cortex.Route("resource_definitions", "business-unit"),
+		&cortex.ResourceDefinition{Type: "business-unit", Name: req.Name},
+		AssertRequestMethod(t, "PUT"),
+		AssertRequestBodyString(t, `{"name":"Business Unit"}`),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	_, err = c.ResourceDefinitions().Update(context.Background(), "business-unit", req)
+	assert.Nil(t, err, "error updating a resource definition")
+}
@@ -0,0 +1,110 @@
+package cortex
+
+import (
+	"context"
+	"errors"
+	"github.com/dghubble/sling"
+)
+
+type RoleAssignmentsClientInterface interface {
+	Get(ctx context.Context, id string) (RoleAssignment, error)
+	Create(ctx context.Context, req CreateRoleAssignmentRequest) (RoleAssignment, error)
+	Delete(ctx context.Context, id string) error
+}
+
+type RoleAssignmentsClient struct {
+	client *HttpClient
+}
+
+var _ RoleAssignmentsClientInterface = &RoleAssignmentsClient{}
+
+func (c *RoleAssignmentsClient) Client() *sling.Sling {
+	return c.client.Client()
+}
+
+/***********************************************************************************************************************
+ * Types
+ **********************************************************************************************************************/
+
+// RoleAssignment assigns a role to either a user, by email, or to every member of a team, by tag. Exactly one of
+// UserEmail and TeamTag is set.
+type RoleAssignment struct {
+	Id        string `json:"id,omitempty"`
+	RoleTag   string `json:"roleTag"`
+	UserEmail string `json:"userEmail,omitempty"`
+	TeamTag   string `json:"teamTag,omitempty"`
+}
+
+/***********************************************************************************************************************
+ * GET /api/v1/role-assignments/:id
+ **********************************************************************************************************************/
+
+func (c *RoleAssignmentsClient) Get(ctx context.Context, id string) (RoleAssignment, error) {
+	assignment := RoleAssignment{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Get(Route("role_assignments", id)), &assignment, &apiError)
+	if err != nil {
+		return assignment, errors.New("could not get role assignment: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return assignment, err
+	}
+
+	return assignment, nil
+}
+
+/***********************************************************************************************************************
+ * POST /api/v1/role-assignments
+ **********************************************************************************************************************/
+
+type CreateRoleAssignmentRequest struct {
+	RoleTag   string `json:"roleTag"`
+	UserEmail string `json:"userEmail,omitempty"`
+	TeamTag   string `json:"teamTag,omitempty"`
+}
+
+func (r *RoleAssignment) ToCreateRequest() CreateRoleAssignmentRequest {
+	return CreateRoleAssignmentRequest{
+		RoleTag:   r.RoleTag,
+		UserEmail: r.UserEmail,
+		TeamTag:   r.TeamTag,
+	}
+}
+
+func (c *RoleAssignmentsClient) Create(ctx context.Context, req CreateRoleAssignmentRequest) (RoleAssignment, error) {
+	assignment := RoleAssignment{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Post(Route("role_assignments", "")).BodyJSON(&req), &assignment, &apiError)
+	if err != nil {
+		return assignment, errors.New("could not create role assignment: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return assignment, err
+	}
+
+	return assignment, nil
+}
+
+/***********************************************************************************************************************
+ * DELETE /api/v1/role-assignments/:id
+ **********************************************************************************************************************/
+
+type DeleteRoleAssignmentResponse struct{}
+
+func (c *RoleAssignmentsClient) Delete(ctx context.Context, id string) error {
+	deleteResponse := DeleteRoleAssignmentResponse{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Delete(Route("role_assignments", id)), &deleteResponse, &apiError)
+	if err != nil {
+		return errors.New("could not delete role assignment: " + err.Error())
+	}
+
+	return c.client.handleResponseStatus(response, &apiError)
+}
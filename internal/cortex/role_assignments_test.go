@@ -0,0 +1,53 @@
+package cortex_test
+
+import (
+	"context"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var testRoleAssignment = cortex.RoleAssignment{
+	Id:      "ra-1",
+	RoleTag: "deployer",
+	TeamTag: "platform",
+}
+
+func TestGetRoleAssignment(t *testing.T) {
+	c, teardown, err := setupClient(cortex.Route("role_assignments", testRoleAssignment.Id), testRoleAssignment, AssertRequestMethod(t, "GET"))
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.RoleAssignments().Get(context.Background(), testRoleAssignment.Id)
+	assert.Nil(t, err, "error retrieving a role assignment")
+	assert.Equal(t, testRoleAssignment, res)
+}
+
+func TestCreateRoleAssignment(t *testing.T) {
+	req := testRoleAssignment.ToCreateRequest()
+	c, teardown, err := setupClient(
+		cortex.Route("role_assignments", ""),
+		testRoleAssignment,
+		AssertRequestMethod(t, "POST"),
+		AssertRequestBody(t, req),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.RoleAssignments().Create(context.Background(), req)
+	assert.Nil(t, err, "error creating a role assignment")
+	assert.Equal(t, testRoleAssignment, res)
+}
+
+func TestDeleteRoleAssignment(t *testing.T) {
+	c, teardown, err := setupClient(
+		cortex.Route("role_assignments", testRoleAssignment.Id),
+		cortex.DeleteRoleAssignmentResponse{},
+		AssertRequestMethod(t, "DELETE"),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	err = c.RoleAssignments().Delete(context.Background(), testRoleAssignment.Id)
+	assert.Nil(t, err, "error deleting a role assignment")
+}
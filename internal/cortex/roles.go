@@ -0,0 +1,148 @@
+package cortex
+
+import (
+	"context"
+	"errors"
+	"github.com/dghubble/sling"
+)
+
+type RolesClientInterface interface {
+	Get(ctx context.Context, tag string) (Role, error)
+	Create(ctx context.Context, req CreateRoleRequest) (Role, error)
+	Update(ctx context.Context, tag string, req UpdateRoleRequest) (Role, error)
+	Delete(ctx context.Context, tag string) error
+}
+
+type RolesClient struct {
+	client *HttpClient
+}
+
+var _ RolesClientInterface = &RolesClient{}
+
+func (c *RolesClient) Client() *sling.Sling {
+	return c.client.Client()
+}
+
+/***********************************************************************************************************************
+ * Types
+ **********************************************************************************************************************/
+
+// Role is a custom role, granting the identifiers in Permissions to the users and teams it is assigned to. The
+// available permission identifiers are listed by the permissions endpoint.
+type Role struct {
+	Tag         string   `json:"tag"`
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Permissions []string `json:"permissions"`
+}
+
+/***********************************************************************************************************************
+ * GET /api/v1/roles/:tag
+ **********************************************************************************************************************/
+
+func (c *RolesClient) Get(ctx context.Context, tag string) (Role, error) {
+	role := Role{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Get(Route("roles", tag)), &role, &apiError)
+	if err != nil {
+		return role, errors.New("could not get role: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return role, err
+	}
+
+	return role, nil
+}
+
+/***********************************************************************************************************************
+ * POST /api/v1/roles
+ **********************************************************************************************************************/
+
+type CreateRoleRequest struct {
+	Tag         string   `json:"tag"`
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Permissions []string `json:"permissions"`
+}
+
+func (r *Role) ToCreateRequest() CreateRoleRequest {
+	return CreateRoleRequest{
+		Tag:         r.Tag,
+		Name:        r.Name,
+		Description: r.Description,
+		Permissions: r.Permissions,
+	}
+}
+
+func (c *RolesClient) Create(ctx context.Context, req CreateRoleRequest) (Role, error) {
+	role := Role{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Post(Route("roles", "")).BodyJSON(&req), &role, &apiError)
+	if err != nil {
+		return role, errors.New("could not create role: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return role, err
+	}
+
+	return role, nil
+}
+
+/***********************************************************************************************************************
+ * PUT /api/v1/roles/:tag
+ **********************************************************************************************************************/
+
+type UpdateRoleRequest struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Permissions []string `json:"permissions"`
+}
+
+func (r *Role) ToUpdateRequest() UpdateRoleRequest {
+	return UpdateRoleRequest{
+		Name:        r.Name,
+		Description: r.Description,
+		Permissions: r.Permissions,
+	}
+}
+
+func (c *RolesClient) Update(ctx context.Context, tag string, req UpdateRoleRequest) (Role, error) {
+	role := Role{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Put(Route("roles", tag)).BodyJSON(&req), &role, &apiError)
+	if err != nil {
+		return role, errors.New("could not update role: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return role, err
+	}
+
+	return role, nil
+}
+
+/***********************************************************************************************************************
+ * DELETE /api/v1/roles/:tag
+ **********************************************************************************************************************/
+
+type DeleteRoleResponse struct{}
+
+func (c *RolesClient) Delete(ctx context.Context, tag string) error {
+	deleteResponse := DeleteRoleResponse{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Delete(Route("roles", tag)), &deleteResponse, &apiError)
+	if err != nil {
+		return errors.New("could not delete role: " + err.Error())
+	}
+
+	return c.client.handleResponseStatus(response, &apiError)
+}
@@ -0,0 +1,72 @@
+package cortex_test
+
+import (
+	"context"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var testRole = cortex.Role{
+	Tag:         "deployer",
+	Name:        "Deployer",
+	Description: "Can record deploys",
+	Permissions: []string{"VIEW_CATALOG", "EDIT_DEPLOYS"},
+}
+
+func TestGetRole(t *testing.T) {
+	c, teardown, err := setupClient(cortex.Route("roles", testRole.Tag), testRole, AssertRequestMethod(t, "GET"))
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.Roles().Get(context.Background(), testRole.Tag)
+	assert.Nil(t, err, "error retrieving a role")
+	assert.Equal(t, testRole, res)
+}
+
+func TestCreateRole(t *testing.T) {
+	req := testRole.ToCreateRequest()
+	c, teardown, err := setupClient(
+		cortex.Route("roles", ""),
+		testRole,
+		AssertRequestMethod(t, "POST"),
+		AssertRequestBody(t, req),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.Roles().Create(context.Background(), req)
+	assert.Nil(t, err, "error creating a role")
+	assert.Equal(t, testRole, res)
+}
+
+func TestUpdateRole(t *testing.T) {
+	updated := testRole
+	updated.Permissions = []string{"VIEW_CATALOG"}
+	req := updated.ToUpdateRequest()
+	c, teardown, err := setupClient(
+		cortex.Route("roles", testRole.Tag),
+		updated,
+		AssertRequestMethod(t, "PUT"),
+		AssertRequestBody(t, req),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.Roles().Update(context.Background(), testRole.Tag, req)
+	assert.Nil(t, err, "error updating a role")
+	assert.Equal(t, updated, res)
+}
+
+func TestDeleteRole(t *testing.T) {
+	c, teardown, err := setupClient(
+		cortex.Route("roles", testRole.Tag),
+		cortex.DeleteRoleResponse{},
+		AssertRequestMethod(t, "DELETE"),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	err = c.Roles().Delete(context.Background(), testRole.Tag)
+	assert.Nil(t, err, "error deleting a role")
+}
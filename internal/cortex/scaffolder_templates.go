@@ -0,0 +1,211 @@
+package cortex
+
+import (
+	"context"
+	"errors"
+	"github.com/dghubble/sling"
+)
+
+type ScaffolderTemplatesClientInterface interface {
+	Get(ctx context.Context, tag string) (ScaffolderTemplate, error)
+	List(ctx context.Context, params *ScaffolderTemplateListParams) (*ScaffolderTemplatesResponse, error)
+	Create(ctx context.Context, req UpsertScaffolderTemplateRequest) (ScaffolderTemplate, error)
+	Update(ctx context.Context, tag string, req UpsertScaffolderTemplateRequest) (ScaffolderTemplate, error)
+	Delete(ctx context.Context, tag string) error
+}
+
+type ScaffolderTemplatesClient struct {
+	client *HttpClient
+}
+
+var _ ScaffolderTemplatesClientInterface = &ScaffolderTemplatesClient{}
+
+func (c *ScaffolderTemplatesClient) Client() *sling.Sling {
+	return c.client.Client()
+}
+
+/***********************************************************************************************************************
+ * Types
+ **********************************************************************************************************************/
+
+const (
+	ScaffolderTemplateGitProviderGithub      = "GITHUB"
+	ScaffolderTemplateGitProviderGitlab      = "GITLAB"
+	ScaffolderTemplateGitProviderBitbucket   = "BITBUCKET"
+	ScaffolderTemplateGitProviderAzureDevops = "AZURE_DEVOPS"
+
+	ScaffolderTemplateOwnerTypeEmail = "EMAIL"
+	ScaffolderTemplateOwnerTypeGroup = "GROUP"
+)
+
+// ScaffolderTemplate is a Cookiecutter template that the Scaffolder uses to create new repositories and the catalog
+// entities for them.
+type ScaffolderTemplate struct {
+	Tag           string                        `json:"tag"`
+	Name          string                        `json:"name"`
+	Description   string                        `json:"description,omitempty"`
+	RepositoryUrl string                        `json:"repositoryUrl"`
+	Branch        string                        `json:"branch,omitempty"`
+	Path          string                        `json:"path,omitempty"`
+	Inputs        []ScaffolderTemplateInput     `json:"inputs"`
+	DefaultOwners []ScaffolderTemplateOwner     `json:"defaultOwners"`
+	DefaultGroups []string                      `json:"defaultGroups"`
+	GitProvider   ScaffolderTemplateGitProvider `json:"gitProvider"`
+}
+
+// ScaffolderTemplateInput overrides how a Cookiecutter variable is presented to the user running the template.
+type ScaffolderTemplateInput struct {
+	Name         string `json:"name"`
+	Description  string `json:"description,omitempty"`
+	DefaultValue string `json:"defaultValue,omitempty"`
+	Required     bool   `json:"required"`
+}
+
+// ScaffolderTemplateOwner is an owner assigned to entities created from the template.
+type ScaffolderTemplateOwner struct {
+	Type     string `json:"type"`
+	Name     string `json:"name,omitempty"`
+	Email    string `json:"email,omitempty"`
+	Provider string `json:"provider,omitempty"`
+}
+
+// ScaffolderTemplateGitProvider is where repositories created from the template are pushed to. Alias selects one of
+// several configurations of the same integration.
+type ScaffolderTemplateGitProvider struct {
+	Type         string `json:"type"`
+	Alias        string `json:"alias,omitempty"`
+	Organization string `json:"organization,omitempty"`
+}
+
+/***********************************************************************************************************************
+ * GET /api/v1/scaffolder/templates/:tag
+ **********************************************************************************************************************/
+
+func (c *ScaffolderTemplatesClient) Get(ctx context.Context, tag string) (ScaffolderTemplate, error) {
+	template := ScaffolderTemplate{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Get(Route("scaffolder_templates", tag)), &template, &apiError)
+	if err != nil {
+		return template, errors.New("could not get scaffolder template: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return template, err
+	}
+
+	return template, nil
+}
+
+/***********************************************************************************************************************
+ * GET /api/v1/scaffolder/templates
+ **********************************************************************************************************************/
+
+// ScaffolderTemplateListParams are the query parameters for the GET /v1/scaffolder/templates endpoint.
+type ScaffolderTemplateListParams struct {
+	Page     int `url:"page,omitempty"`
+	PageSize int `url:"pageSize,omitempty"`
+}
+
+// ScaffolderTemplatesResponse is a page of scaffolder templates.
+type ScaffolderTemplatesResponse struct {
+	Templates  []ScaffolderTemplate `json:"templates"`
+	Page       int                  `json:"page"`
+	TotalPages int                  `json:"totalPages"`
+	Total      int                  `json:"total"`
+}
+
+func (c *ScaffolderTemplatesClient) List(ctx context.Context, params *ScaffolderTemplateListParams) (*ScaffolderTemplatesResponse, error) {
+	templatesResponse := &ScaffolderTemplatesResponse{}
+	apiError := &ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Get(Route("scaffolder_templates", "")).QueryStruct(params), templatesResponse, apiError)
+	if err != nil {
+		return nil, errors.New("could not get scaffolder templates: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, apiError)
+	if err != nil {
+		return nil, err
+	}
+
+	return templatesResponse, nil
+}
+
+/***********************************************************************************************************************
+ * POST /api/v1/scaffolder/templates
+ **********************************************************************************************************************/
+
+type UpsertScaffolderTemplateRequest struct {
+	Tag           string                        `json:"tag"`
+	Name          string                        `json:"name"`
+	Description   string                        `json:"description,omitempty"`
+	RepositoryUrl string                        `json:"repositoryUrl"`
+	Branch        string                        `json:"branch,omitempty"`
+	Path          string                        `json:"path,omitempty"`
+	Inputs        []ScaffolderTemplateInput     `json:"inputs"`
+	DefaultOwners []ScaffolderTemplateOwner     `json:"defaultOwners"`
+	DefaultGroups []string                      `json:"defaultGroups"`
+	GitProvider   ScaffolderTemplateGitProvider `json:"gitProvider"`
+}
+
+func (r *ScaffolderTemplate) ToUpsertRequest() UpsertScaffolderTemplateRequest {
+	return UpsertScaffolderTemplateRequest(*r)
+}
+
+func (c *ScaffolderTemplatesClient) Create(ctx context.Context, req UpsertScaffolderTemplateRequest) (ScaffolderTemplate, error) {
+	template := ScaffolderTemplate{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Post(Route("scaffolder_templates", "")).BodyJSON(&req), &template, &apiError)
+	if err != nil {
+		return template, errors.New("could not create scaffolder template: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return template, err
+	}
+
+	return template, nil
+}
+
+/***********************************************************************************************************************
+ * PUT /api/v1/scaffolder/templates/:tag
+ **********************************************************************************************************************/
+
+func (c *ScaffolderTemplatesClient) Update(ctx context.Context, tag string, req UpsertScaffolderTemplateRequest) (ScaffolderTemplate, error) {
+	template := ScaffolderTemplate{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Put(Route("scaffolder_templates", tag)).BodyJSON(&req), &template, &apiError)
+	if err != nil {
+		return template, errors.New("could not update scaffolder template: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return template, err
+	}
+
+	return template, nil
+}
+
+/***********************************************************************************************************************
+ * DELETE /api/v1/scaffolder/templates/:tag
+ **********************************************************************************************************************/
+
+type DeleteScaffolderTemplateResponse struct{}
+
+func (c *ScaffolderTemplatesClient) Delete(ctx context.Context, tag string) error {
+	deleteResponse := DeleteScaffolderTemplateResponse{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Delete(Route("scaffolder_templates", tag)), &deleteResponse, &apiError)
+	if err != nil {
+		return errors.New("could not delete scaffolder template: " + err.Error())
+	}
+
+	return c.client.handleResponseStatus(response, &apiError)
+}
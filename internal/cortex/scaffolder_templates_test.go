@@ -0,0 +1,104 @@
+package cortex_test
+
+import (
+	"context"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var testScaffolderTemplate = cortex.ScaffolderTemplate{
+	Tag:           "go-service",
+	Name:          "Go service",
+	Description:   "Golden path for Go HTTP services",
+	RepositoryUrl: "https://github.com/cortexapps/cookiecutter-go-service",
+	Branch:        "main",
+	Inputs: []cortex.ScaffolderTemplateInput{
+		{Name: "service_name", Description: "Name of the new service", Required: true},
+		{Name: "go_version", DefaultValue: "1.24"},
+	},
+	DefaultOwners: []cortex.ScaffolderTemplateOwner{
+		{Type: cortex.ScaffolderTemplateOwnerTypeGroup, Name: "platform", Provider: "CORTEX"},
+	},
+	DefaultGroups: []string{"golden-path"},
+	GitProvider: cortex.ScaffolderTemplateGitProvider{
+		Type:         cortex.ScaffolderTemplateGitProviderGithub,
+		Organization: "cortexapps",
+	},
+}
+
+func TestGetScaffolderTemplate(t *testing.T) {
+	c, teardown, err := setupClient(
+		cortex.Route("scaffolder_templates", testScaffolderTemplate.Tag),
+		testScaffolderTemplate,
+		AssertRequestMethod(t, "GET"),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.ScaffolderTemplates().Get(context.Background(), testScaffolderTemplate.Tag)
+	assert.Nil(t, err, "error retrieving a scaffolder template")
+	assert.Equal(t, testScaffolderTemplate, res)
+}
+
+func TestListScaffolderTemplates(t *testing.T) {
+	c, teardown, err := setupClient(
+		cortex.Route("scaffolder_templates", ""),
+		cortex.ScaffolderTemplatesResponse{Templates: []cortex.ScaffolderTemplate{testScaffolderTemplate}, Page: 0, TotalPages: 1, Total: 1},
+		AssertRequestMethod(t, "GET"),
+		AssertRequestURI(t, cortex.Route("scaffolder_templates", "")+"?page=1&pageSize=10"),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.ScaffolderTemplates().List(context.Background(), &cortex.ScaffolderTemplateListParams{Page: 1, PageSize: 10})
+	assert.Nil(t, err, "error listing scaffolder templates")
+	assert.Equal(t, []cortex.ScaffolderTemplate{testScaffolderTemplate}, res.Templates)
+}
+
+func TestCreateScaffolderTemplate(t *testing.T) {
+	req := testScaffolderTemplate.ToUpsertRequest()
+	c, teardown, err := setupClient(
+		cortex.Route("scaffolder_templates", ""),
+		testScaffolderTemplate,
+		AssertRequestMethod(t, "POST"),
+		AssertRequestBody(t, req),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.ScaffolderTemplates().Create(context.Background(), req)
+	assert.Nil(t, err, "error creating a scaffolder template")
+	assert.Equal(t, testScaffolderTemplate, res)
+}
+
+func TestUpdateScaffolderTemplate(t *testing.T) {
+	updated := testScaffolderTemplate
+	updated.Branch = "v2"
+	req := updated.ToUpsertRequest()
+	c, teardown, err := setupClient(
+		cortex.Route("scaffolder_templates", testScaffolderTemplate.Tag),
+		updated,
+		AssertRequestMethod(t, "PUT"),
+		AssertRequestBody(t, req),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.ScaffolderTemplates().Update(context.Background(), testScaffolderTemplate.Tag, req)
+	assert.Nil(t, err, "error updating a scaffolder template")
+	assert.Equal(t, updated, res)
+}
+
+func TestDeleteScaffolderTemplate(t *testing.T) {
+	c, teardown, err := setupClient(
+		cortex.Route("scaffolder_templates", testScaffolderTemplate.Tag),
+		cortex.DeleteScaffolderTemplateResponse{},
+		AssertRequestMethod(t, "DELETE"),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	err = c.ScaffolderTemplates().Delete(context.Background(), testScaffolderTemplate.Tag)
+	assert.Nil(t, err, "error deleting a scaffolder template")
+}
@@ -0,0 +1,196 @@
+package cortex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/dghubble/sling"
+)
+
+type ScorecardRuleExemptionsClientInterface interface {
+	Get(ctx context.Context, scorecardTag string, entityTag string, ruleIdentifier string) (ScorecardRuleExemption, error)
+	List(ctx context.Context, scorecardTag string, params ScorecardRuleExemptionListParams) ([]ScorecardRuleExemption, error)
+	Request(ctx context.Context, scorecardTag string, entityTag string, req RequestScorecardRuleExemptionRequest) (ScorecardRuleExemption, error)
+	Approve(ctx context.Context, scorecardTag string, entityTag string, ruleIdentifier string) (ScorecardRuleExemption, error)
+	Revoke(ctx context.Context, scorecardTag string, entityTag string, req RevokeScorecardRuleExemptionRequest) error
+}
+
+type ScorecardRuleExemptionsClient struct {
+	client *HttpClient
+}
+
+var _ ScorecardRuleExemptionsClientInterface = &ScorecardRuleExemptionsClient{}
+
+func (c *ScorecardRuleExemptionsClient) Client() *sling.Sling {
+	return c.client.Client()
+}
+
+/***********************************************************************************************************************
+ * Types
+ **********************************************************************************************************************/
+
+const (
+	ScorecardRuleExemptionStatusPending  = "PENDING"
+	ScorecardRuleExemptionStatusApproved = "APPROVED"
+	ScorecardRuleExemptionStatusRejected = "REJECTED"
+	ScorecardRuleExemptionStatusRevoked  = "REVOKED"
+	ScorecardRuleExemptionStatusExpired  = "EXPIRED"
+)
+
+// ScorecardRuleExemption exempts a single entity from a single scorecard rule.
+type ScorecardRuleExemption struct {
+	ScorecardTag   string `json:"scorecardTag"`
+	EntityTag      string `json:"entityTag"`
+	RuleIdentifier string `json:"ruleIdentifier"`
+	Reason         string `json:"reason"`
+	Status         string `json:"status"`
+	EndDate        string `json:"endDate,omitempty"`
+}
+
+func (e *ScorecardRuleExemption) ID() string {
+	return e.ScorecardTag + ":" + e.EntityTag + ":" + e.RuleIdentifier
+}
+
+// Active returns true if the exemption is pending approval or currently applies.
+func (e *ScorecardRuleExemption) Active() bool {
+	return e.Status == ScorecardRuleExemptionStatusPending || e.Status == ScorecardRuleExemptionStatusApproved
+}
+
+func (c *ScorecardRuleExemptionsClient) entityRoute(scorecardTag string, entityTag string, path string) string {
+	return Route("scorecards", scorecardTag+"/entity/"+entityTag+"/exemption"+path)
+}
+
+/***********************************************************************************************************************
+ * GET /api/v1/scorecards/:tag/exemptions
+ **********************************************************************************************************************/
+
+// ScorecardRuleExemptionListParams are the query parameters for the GET /v1/scorecards/:tag/exemptions endpoint.
+type ScorecardRuleExemptionListParams struct {
+	EntityTag string `url:"entityTag,omitempty"`
+}
+
+type ScorecardRuleExemptionsResponse struct {
+	Exemptions []ScorecardRuleExemption `json:"exemptions"`
+}
+
+func (c *ScorecardRuleExemptionsClient) List(ctx context.Context, scorecardTag string, params ScorecardRuleExemptionListParams) ([]ScorecardRuleExemption, error) {
+	exemptionsResponse := ScorecardRuleExemptionsResponse{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Get(Route("scorecards", scorecardTag+"/exemptions")).QueryStruct(&params), &exemptionsResponse, &apiError)
+	if err != nil {
+		return nil, errors.New("could not get scorecard rule exemptions: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range exemptionsResponse.Exemptions {
+		exemptionsResponse.Exemptions[i].ScorecardTag = scorecardTag
+	}
+	return exemptionsResponse.Exemptions, nil
+}
+
+// Get returns the exemption of an entity from a rule. The API keeps the history of exemptions, so an active exemption
+// is preferred over the most recent inactive one. Returns ApiErrorNotFound if the entity was never exempted.
+func (c *ScorecardRuleExemptionsClient) Get(ctx context.Context, scorecardTag string, entityTag string, ruleIdentifier string) (ScorecardRuleExemption, error) {
+	exemptions, err := c.List(ctx, scorecardTag, ScorecardRuleExemptionListParams{EntityTag: entityTag})
+	if err != nil {
+		return ScorecardRuleExemption{}, err
+	}
+
+	var found *ScorecardRuleExemption
+	for i, exemption := range exemptions {
+		if exemption.EntityTag != entityTag || exemption.RuleIdentifier != ruleIdentifier {
+			continue
+		}
+		if exemption.Active() {
+			return exemption, nil
+		}
+		found = &exemptions[i]
+	}
+	if found == nil {
+		return ScorecardRuleExemption{}, fmt.Errorf("scorecard rule exemption %s:%s:%s: %w", scorecardTag, entityTag, ruleIdentifier, ApiErrorNotFound)
+	}
+	return *found, nil
+}
+
+/***********************************************************************************************************************
+ * POST /api/v1/scorecards/:tag/entity/:entityTag/exemption
+ **********************************************************************************************************************/
+
+type RequestScorecardRuleExemptionRequest struct {
+	RuleIdentifier string `json:"ruleIdentifier"`
+	Reason         string `json:"reason"`
+	Days           int64  `json:"days,omitempty"`
+}
+
+func (c *ScorecardRuleExemptionsClient) Request(ctx context.Context, scorecardTag string, entityTag string, req RequestScorecardRuleExemptionRequest) (ScorecardRuleExemption, error) {
+	exemption := ScorecardRuleExemption{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Post(c.entityRoute(scorecardTag, entityTag, "")).BodyJSON(&req), &exemption, &apiError)
+	if err != nil {
+		return exemption, errors.New("could not request scorecard rule exemption: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return exemption, err
+	}
+
+	exemption.ScorecardTag = scorecardTag
+	return exemption, nil
+}
+
+/***********************************************************************************************************************
+ * POST /api/v1/scorecards/:tag/entity/:entityTag/exemption/approve
+ **********************************************************************************************************************/
+
+type ApproveScorecardRuleExemptionRequest struct {
+	RuleIdentifier string `json:"ruleIdentifier"`
+}
+
+func (c *ScorecardRuleExemptionsClient) Approve(ctx context.Context, scorecardTag string, entityTag string, ruleIdentifier string) (ScorecardRuleExemption, error) {
+	exemption := ScorecardRuleExemption{}
+	apiError := ApiError{}
+	req := ApproveScorecardRuleExemptionRequest{RuleIdentifier: ruleIdentifier}
+
+	response, err := c.client.receive(ctx, c.Client().Post(c.entityRoute(scorecardTag, entityTag, "/approve")).BodyJSON(&req), &exemption, &apiError)
+	if err != nil {
+		return exemption, errors.New("could not approve scorecard rule exemption: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return exemption, err
+	}
+
+	exemption.ScorecardTag = scorecardTag
+	return exemption, nil
+}
+
+/***********************************************************************************************************************
+ * POST /api/v1/scorecards/:tag/entity/:entityTag/exemption/revoke
+ **********************************************************************************************************************/
+
+type RevokeScorecardRuleExemptionRequest struct {
+	RuleIdentifier string `json:"ruleIdentifier"`
+	Reason         string `json:"reason"`
+}
+
+type RevokeScorecardRuleExemptionResponse struct{}
+
+func (c *ScorecardRuleExemptionsClient) Revoke(ctx context.Context, scorecardTag string, entityTag string, req RevokeScorecardRuleExemptionRequest) error {
+	revokeResponse := RevokeScorecardRuleExemptionResponse{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Post(c.entityRoute(scorecardTag, entityTag, "/revoke")).BodyJSON(&req), &revokeResponse, &apiError)
+	if err != nil {
+		return errors.New("could not revoke scorecard rule exemption: " + err.Error())
+	}
+
+	return c.client.handleResponseStatus(response, &apiError)
+}
@@ -0,0 +1,94 @@
+package cortex_test
+
+import (
+	"context"
+	"errors"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var testScorecardRuleExemption = cortex.ScorecardRuleExemption{
+	EntityTag:      "test-service",
+	RuleIdentifier: "has-oncall",
+	Reason:         "Service is being decommissioned",
+	Status:         cortex.ScorecardRuleExemptionStatusApproved,
+	EndDate:        "2026-12-31T00:00:00Z",
+}
+
+func TestGetScorecardRuleExemption(t *testing.T) {
+	revoked := testScorecardRuleExemption
+	revoked.Status = cortex.ScorecardRuleExemptionStatusRevoked
+	other := testScorecardRuleExemption
+	other.RuleIdentifier = "has-readme"
+
+	c, teardown, err := setupClient(
+		cortex.Route("scorecards", "test-scorecard/exemptions"),
+		cortex.ScorecardRuleExemptionsResponse{Exemptions: []cortex.ScorecardRuleExemption{revoked, testScorecardRuleExemption, other}},
+		AssertRequestMethod(t, "GET"),
+		AssertRequestURI(t, cortex.Route("scorecards", "test-scorecard/exemptions")+"?entityTag=test-service"),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.ScorecardRuleExemptions().Get(context.Background(), "test-scorecard", "test-service", "has-oncall")
+	assert.Nil(t, err, "error retrieving a scorecard rule exemption")
+	assert.Equal(t, cortex.ScorecardRuleExemptionStatusApproved, res.Status)
+	assert.Equal(t, "test-scorecard:test-service:has-oncall", res.ID())
+
+	_, err = c.ScorecardRuleExemptions().Get(context.Background(), "test-scorecard", "test-service", "has-runbook")
+	assert.True(t, errors.Is(err, cortex.ApiErrorNotFound), "expected not found, got %v", err)
+}
+
+func TestRequestScorecardRuleExemption(t *testing.T) {
+	req := cortex.RequestScorecardRuleExemptionRequest{
+		RuleIdentifier: "has-oncall",
+		Reason:         "Service is being decommissioned",
+		Days:           30,
+	}
+	pending := testScorecardRuleExemption
+	pending.Status = cortex.ScorecardRuleExemptionStatusPending
+	c, teardown, err := setupClient(
+		cortex.Route("scorecards", "test-scorecard/entity/test-service/exemption"),
+		pending,
+		AssertRequestMethod(t, "POST"),
+		AssertRequestBody(t, req),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.ScorecardRuleExemptions().Request(context.Background(), "test-scorecard", "test-service", req)
+	assert.Nil(t, err, "error requesting a scorecard rule exemption")
+	assert.Equal(t, cortex.ScorecardRuleExemptionStatusPending, res.Status)
+	assert.Equal(t, "test-scorecard", res.ScorecardTag)
+}
+
+func TestApproveScorecardRuleExemption(t *testing.T) {
+	c, teardown, err := setupClient(
+		cortex.Route("scorecards", "test-scorecard/entity/test-service/exemption/approve"),
+		testScorecardRuleExemption,
+		AssertRequestMethod(t, "POST"),
+		AssertRequestBody(t, cortex.ApproveScorecardRuleExemptionRequest{RuleIdentifier: "has-oncall"}),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.ScorecardRuleExemptions().Approve(context.Background(), "test-scorecard", "test-service", "has-oncall")
+	assert.Nil(t, err, "error approving a scorecard rule exemption")
+	assert.True(t, res.Active())
+}
+
+func TestRevokeScorecardRuleExemption(t *testing.T) {
+	req := cortex.RevokeScorecardRuleExemptionRequest{RuleIdentifier: "has-oncall", Reason: "No longer needed"}
+	c, teardown, err := setupClient(
+		cortex.Route("scorecards", "test-scorecard/entity/test-service/exemption/revoke"),
+		cortex.RevokeScorecardRuleExemptionResponse{},
+		AssertRequestMethod(t, "POST"),
+		AssertRequestBody(t, req),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	err = c.ScorecardRuleExemptions().Revoke(context.Background(), "test-scorecard", "test-service", req)
+	assert.Nil(t, err, "error revoking a scorecard rule exemption")
+}
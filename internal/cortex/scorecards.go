@@ -5,7 +5,6 @@ import (
 	"errors"
 	"fmt"
 	"github.com/dghubble/sling"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"gopkg.in/yaml.v3"
 	"strings"
 )
@@ -14,6 +13,10 @@ type ScorecardsClientInterface interface {
 	Get(ctx context.Context, tag string) (Scorecard, error)
 	Upsert(ctx context.Context, scorecard Scorecard) (Scorecard, error)
 	Delete(ctx context.Context, tag string) error
+	Scores(ctx context.Context, tag string, params *ScorecardScoresParams) (*ScorecardScoresResponse, error)
+	AllScores(ctx context.Context, tag string, entityTag string) ([]ScorecardEntityScore, error)
+	LastEvaluated(ctx context.Context, tag string, entityTag string) (map[string]string, error)
+	Evaluate(ctx context.Context, tag string, entityTag string) error
 }
 
 type ScorecardsClient struct {
@@ -136,7 +139,7 @@ func (c *ScorecardsClient) Get(ctx context.Context, tag string) (Scorecard, erro
 
 	uri := Route("scorecards", tag+"/descriptor")
 	cl := c.YamlClient().Get(uri)
-	response, err := cl.Receive(scorecardDescriptorResponse, &apiError)
+	response, err := c.client.receive(ctx, cl, scorecardDescriptorResponse, &apiError)
 	if err != nil {
 		return Scorecard{}, errors.Join(fmt.Errorf("failed getting scorecard descriptor for %s from %s", tag, uri), err)
 	}
@@ -145,8 +148,6 @@ func (c *ScorecardsClient) Get(ctx context.Context, tag string) (Scorecard, erro
 		return Scorecard{}, errors.Join(fmt.Errorf("failed handling response status for %s from %s", tag, uri), err)
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("body: %+v", scorecardDescriptorResponse))
-
 	return c.parser.YamlToEntity(scorecardDescriptorResponse)
 }
 
@@ -165,18 +166,21 @@ func (c *ScorecardsClient) Upsert(ctx context.Context, scorecard Scorecard) (Sco
 	apiError := ApiError{}
 
 	// The API requires submitting the request as YAML, so we need to marshal it first.
-	body, err := scorecard.ToYamlStringReader()
+	yamlBody, err := scorecard.ToYaml()
 	if err != nil {
 		return upsertResponse.Scorecard, errors.New("could not marshal yaml: " + err.Error())
 	}
+	body := strings.NewReader(yamlBody)
 
-	tflog.Debug(ctx, fmt.Sprintf("CREATE body: %+v", body))
-	response, err := c.Client().
+	logCtx := withRequestLog(ctx, "Upserting scorecard descriptor", map[string]interface{}{
+		"body": redactYaml(yamlBody),
+	})
+	cl := c.Client().
 		Set("Content-Type", "application/yaml;charset=UTF-8").
 		Set("Accept", "application/json").
 		Post(Route("scorecards", "descriptor")).
-		Body(body).
-		Receive(&upsertResponse, &apiError)
+		Body(body)
+	response, err := c.client.receive(logCtx, cl, &upsertResponse, &apiError)
 	if err != nil {
 		return upsertResponse.Scorecard, errors.New("could not upsert scorecard: " + err.Error())
 	}
@@ -200,7 +204,7 @@ func (c *ScorecardsClient) Delete(ctx context.Context, tag string) error {
 	scorecardResponse := DeleteScorecardResponse{}
 	apiError := ApiError{}
 
-	response, err := c.Client().Delete(Route("scorecards", tag)).Receive(&scorecardResponse, &apiError)
+	response, err := c.client.receive(ctx, c.Client().Delete(Route("scorecards", tag)), &scorecardResponse, &apiError)
 	if err != nil {
 		return errors.New("could not delete scorecard: " + err.Error())
 	}
@@ -212,3 +216,158 @@ func (c *ScorecardsClient) Delete(ctx context.Context, tag string) error {
 
 	return nil
 }
+
+/***********************************************************************************************************************
+ * GET /api/v1/scorecards/:tag/scores
+ **********************************************************************************************************************/
+
+// ScorecardScoresParams are the query parameters for the GET /v1/scorecards/:tag/scores endpoint.
+type ScorecardScoresParams struct {
+	EntityTag string `url:"entityTag,omitempty"`
+	PageSize  int    `url:"pageSize,omitempty"`
+	Page      int    `url:"page,omitempty"`
+}
+
+// ScorecardScoresResponse is the response from the GET /v1/scorecards/:tag/scores endpoint.
+type ScorecardScoresResponse struct {
+	ScorecardTag  string                 `json:"scorecardTag"`
+	ScorecardName string                 `json:"scorecardName"`
+	ServiceScores []ScorecardEntityScore `json:"serviceScores"`
+	Page          int                    `json:"page"`
+	TotalPages    int                    `json:"totalPages"`
+	Total         int                    `json:"total"`
+}
+
+// ScorecardEntityScore is the latest evaluation of a single entity against a scorecard.
+type ScorecardEntityScore struct {
+	Entity        ScorecardScoreEntity `json:"service"`
+	Score         ScorecardScore       `json:"score"`
+	LastEvaluated string               `json:"lastEvaluated,omitempty"`
+}
+
+type ScorecardScoreEntity struct {
+	Tag  string `json:"tag"`
+	Name string `json:"name"`
+	Type string `json:"type,omitempty"`
+}
+
+type ScorecardScore struct {
+	Summary      ScorecardScoreSummary       `json:"summary"`
+	Rules        []ScorecardRuleScore        `json:"rules"`
+	LadderLevels []ScorecardLadderLevelScore `json:"ladderLevels"`
+}
+
+type ScorecardScoreSummary struct {
+	Score              float64 `json:"score"`
+	TotalPossibleScore float64 `json:"totalPossibleScore"`
+}
+
+// Percentage returns the score as a percentage of the total possible score.
+func (s *ScorecardScoreSummary) Percentage() float64 {
+	if s.TotalPossibleScore == 0 {
+		return 0
+	}
+	return s.Score / s.TotalPossibleScore * 100
+}
+
+type ScorecardRuleScore struct {
+	Title      string  `json:"title,omitempty"`
+	Expression string  `json:"expression"`
+	Score      float64 `json:"score"`
+	Error      string  `json:"error,omitempty"`
+}
+
+// Passed returns true if the entity was awarded the rule's points.
+func (r *ScorecardRuleScore) Passed() bool {
+	return r.Score > 0
+}
+
+// ScorecardLadderLevelScore is the level an entity has achieved on the scorecard's ladder. Level is nil when the entity
+// has not achieved any level.
+type ScorecardLadderLevelScore struct {
+	Level *ScorecardLevel `json:"level"`
+}
+
+// CurrentLevel returns the level the entity has achieved, or nil if it has not achieved any.
+func (s *ScorecardScore) CurrentLevel() *ScorecardLevel {
+	for _, l := range s.LadderLevels {
+		if l.Level != nil {
+			return l.Level
+		}
+	}
+	return nil
+}
+
+func (c *ScorecardsClient) Scores(ctx context.Context, tag string, params *ScorecardScoresParams) (*ScorecardScoresResponse, error) {
+	scoresResponse := &ScorecardScoresResponse{}
+	apiError := &ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Get(Route("scorecards", tag+"/scores")).QueryStruct(params), scoresResponse, apiError)
+	if err != nil {
+		return nil, errors.New("could not get scorecard scores: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, apiError)
+	if err != nil {
+		return nil, err
+	}
+
+	return scoresResponse, nil
+}
+
+// AllScores returns the scores of every entity evaluated against the scorecard, fetching every page. If entityTag is
+// set, only that entity is returned.
+func (c *ScorecardsClient) AllScores(ctx context.Context, tag string, entityTag string) ([]ScorecardEntityScore, error) {
+	scores := []ScorecardEntityScore{}
+	params := &ScorecardScoresParams{EntityTag: entityTag, PageSize: 250}
+	for {
+		scoresResponse, err := c.Scores(ctx, tag, params)
+		if err != nil {
+			return nil, err
+		}
+		scores = append(scores, scoresResponse.ServiceScores...)
+		if scoresResponse.Page >= scoresResponse.TotalPages-1 || len(scoresResponse.ServiceScores) == 0 {
+			return scores, nil
+		}
+		params.Page++
+	}
+}
+
+// LastEvaluated returns when each entity was last evaluated against the scorecard, keyed by entity tag. If entityTag
+// is set, only that entity is returned.
+func (c *ScorecardsClient) LastEvaluated(ctx context.Context, tag string, entityTag string) (map[string]string, error) {
+	scores, err := c.AllScores(ctx, tag, entityTag)
+	if err != nil {
+		return nil, err
+	}
+	lastEvaluated := make(map[string]string, len(scores))
+	for _, score := range scores {
+		lastEvaluated[score.Entity.Tag] = score.LastEvaluated
+	}
+	return lastEvaluated, nil
+}
+
+/***********************************************************************************************************************
+ * POST /api/v1/scorecards/:tag/evaluate
+ * POST /api/v1/scorecards/:tag/entity/:entityTag/scores
+ **********************************************************************************************************************/
+
+type EvaluateScorecardResponse struct{}
+
+// Evaluate queues a re-evaluation of the scorecard, or only of entityTag if set. Evaluation happens asynchronously;
+// use LastEvaluated to find out when it has finished.
+func (c *ScorecardsClient) Evaluate(ctx context.Context, tag string, entityTag string) error {
+	evaluateResponse := EvaluateScorecardResponse{}
+	apiError := ApiError{}
+
+	uri := Route("scorecards", tag+"/evaluate")
+	if entityTag != "" {
+		uri = Route("scorecards", tag+"/entity/"+entityTag+"/scores")
+	}
+	response, err := c.client.receive(ctx, c.Client().Post(uri), &evaluateResponse, &apiError)
+	if err != nil {
+		return errors.New("could not evaluate scorecard: " + err.Error())
+	}
+
+	return c.client.handleResponseStatus(response, &apiError)
+}
@@ -41,3 +41,85 @@ func TestDeleteScorecard(t *testing.T) {
 	err = c.Scorecards().Delete(context.Background(), tag)
 	assert.Nil(t, err, "error deleting a scorecard")
 }
+
+func TestGetScorecardScores(t *testing.T) {
+	tag := testScorecard.Tag
+	scores := &cortex.ScorecardScoresResponse{
+		ScorecardTag: tag,
+		ServiceScores: []cortex.ScorecardEntityScore{
+			{
+				Entity: cortex.ScorecardScoreEntity{Tag: "test-service", Name: "Test Service"},
+				Score: cortex.ScorecardScore{
+					Summary: cortex.ScorecardScoreSummary{Score: 1, TotalPossibleScore: 2},
+					Rules: []cortex.ScorecardRuleScore{
+						{Expression: "git != null", Score: 1},
+						{Expression: "oncall != null", Score: 0},
+					},
+					LadderLevels: []cortex.ScorecardLadderLevelScore{
+						{Level: &cortex.ScorecardLevel{Name: "Bronze", Rank: 1}},
+					},
+				},
+			},
+		},
+		TotalPages: 1,
+	}
+	c, teardown, err := setupClient(
+		cortex.Route("scorecards", tag+"/scores"),
+		scores,
+		AssertRequestMethod(t, "GET"),
+		AssertRequestURI(t, cortex.Route("scorecards", tag+"/scores")+"?entityTag=test-service&pageSize=10"),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.Scorecards().Scores(context.Background(), tag, &cortex.ScorecardScoresParams{EntityTag: "test-service", PageSize: 10})
+	assert.Nil(t, err, "error retrieving scorecard scores")
+	assert.Len(t, res.ServiceScores, 1)
+
+	score := res.ServiceScores[0].Score
+	assert.Equal(t, "Bronze", score.CurrentLevel().Name)
+	assert.Equal(t, float64(50), score.Summary.Percentage())
+	assert.True(t, score.Rules[0].Passed())
+	assert.False(t, score.Rules[1].Passed())
+
+	c, teardown, err = setupClient(
+		cortex.Route("scorecards", tag+"/scores"),
+		scores,
+		AssertRequestMethod(t, "GET"),
+		AssertRequestURI(t, cortex.Route("scorecards", tag+"/scores")+"?entityTag=test-service&pageSize=250"),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	allScores, err := c.Scorecards().AllScores(context.Background(), tag, "test-service")
+	assert.Nil(t, err, "error retrieving every page of scorecard scores")
+	assert.Equal(t, scores.ServiceScores, allScores)
+}
+
+func TestEvaluateScorecard(t *testing.T) {
+	tag := testScorecard.Tag
+	c, teardown, err := setupClient(
+		cortex.Route("scorecards", tag+"/evaluate"),
+		cortex.EvaluateScorecardResponse{},
+		AssertRequestMethod(t, "POST"),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	err = c.Scorecards().Evaluate(context.Background(), tag, "")
+	assert.Nil(t, err, "error evaluating a scorecard")
+}
+
+func TestEvaluateScorecardEntity(t *testing.T) {
+	tag := testScorecard.Tag
+	c, teardown, err := setupClient(
+		cortex.Route("scorecards", tag+"/entity/test-service/scores"),
+		cortex.EvaluateScorecardResponse{},
+		AssertRequestMethod(t, "POST"),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	err = c.Scorecards().Evaluate(context.Background(), tag, "test-service")
+	assert.Nil(t, err, "error evaluating a scorecard for an entity")
+}
@@ -91,7 +91,7 @@ type TeamIdpGroupMember struct {
 func (c *TeamsClient) Get(ctx context.Context, tag string) (*Team, error) {
 	teamResponse := &Team{}
 	apiError := &ApiError{}
-	response, err := c.Client().Get(Route("teams", tag)).Receive(teamResponse, apiError)
+	response, err := c.client.receive(ctx, c.Client().Get(Route("teams", tag)), teamResponse, apiError)
 	if err != nil {
 		return teamResponse, errors.New("could not get team: " + err.Error())
 	}
@@ -122,7 +122,7 @@ func (c *TeamsClient) List(ctx context.Context, params *TeamListParams) (*TeamsR
 	teamsResponse := &TeamsResponse{}
 	apiError := &ApiError{}
 
-	response, err := c.Client().Get(Route("teams", "")).QueryStruct(&params).Receive(teamsResponse, apiError)
+	response, err := c.client.receive(ctx, c.Client().Get(Route("teams", "")).QueryStruct(&params), teamsResponse, apiError)
 	if err != nil {
 		return nil, errors.New("could not get teams: " + err.Error())
 	}
@@ -155,7 +155,7 @@ func (c *TeamsClient) Create(ctx context.Context, req CreateTeamRequest) (*Team,
 	teamResponse := &Team{}
 	apiError := &ApiError{}
 
-	response, err := c.Client().Post(Route("teams", "")).BodyJSON(&req).Receive(teamResponse, apiError)
+	response, err := c.client.receive(ctx, c.Client().Post(Route("teams", "")).BodyJSON(&req), teamResponse, apiError)
 	if err != nil {
 		return teamResponse, errors.New("could not create team: " + err.Error())
 	}
@@ -185,7 +185,7 @@ func (c *TeamsClient) Update(ctx context.Context, tag string, req UpdateTeamRequ
 	teamResponse := &Team{}
 	apiError := &ApiError{}
 
-	response, err := c.Client().Put(Route("teams", tag)).BodyJSON(&req).Receive(teamResponse, apiError)
+	response, err := c.client.receive(ctx, c.Client().Put(Route("teams", tag)).BodyJSON(&req), teamResponse, apiError)
 	if err != nil {
 		return teamResponse, errors.New("could not update team: " + err.Error())
 	}
@@ -214,7 +214,7 @@ func (c *TeamsClient) Delete(ctx context.Context, tag string) error {
 	apiError := &ApiError{}
 	req := DeleteTeamRequest{Tag: tag}
 
-	response, err := c.Client().Delete(Route("teams", "")).QueryStruct(req).Receive(teamResponse, apiError)
+	response, err := c.client.receive(ctx, c.Client().Delete(Route("teams", "")).QueryStruct(req), teamResponse, apiError)
 	if err != nil {
 		return fmt.Errorf("could not delete team %v:\n\n%+v", tag, err.Error())
 	}
@@ -238,7 +238,7 @@ func (c *TeamsClient) Archive(ctx context.Context, tag string) error {
 	teamResponse := &ArchiveTeamResponse{}
 	apiError := &ApiError{}
 
-	response, err := c.Client().Put(Route("teams", tag+"/archive")).Receive(teamResponse, apiError)
+	response, err := c.client.receive(ctx, c.Client().Put(Route("teams", tag+"/archive")), teamResponse, apiError)
 	if err != nil {
 		return fmt.Errorf("could not archive team: %v", err.Error())
 	}
@@ -261,7 +261,7 @@ func (c *TeamsClient) Unarchive(ctx context.Context, tag string) error {
 	teamResponse := &UnarchiveTeamResponse{}
 	apiError := &ApiError{}
 
-	response, err := c.Client().Put(Route("teams", tag+"/unarchive")).Receive(teamResponse, apiError)
+	response, err := c.client.receive(ctx, c.Client().Put(Route("teams", tag+"/unarchive")), teamResponse, apiError)
 	if err != nil {
 		return errors.New("could not unarchive team: " + err.Error())
 	}
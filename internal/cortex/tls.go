@@ -0,0 +1,69 @@
+package cortex
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// TLSOptions describes how the client should verify the Cortex API and, optionally, authenticate itself with a
+// client certificate.
+type TLSOptions struct {
+	// CACertFile is a path to a PEM-encoded CA bundle used to verify the Cortex API, in addition to the system roots.
+	CACertFile string
+	// CACertPEM is a PEM-encoded CA bundle used to verify the Cortex API, in addition to the system roots.
+	CACertPEM string
+	// ClientCertPEM and ClientKeyPEM are a PEM-encoded certificate and private key presented for mutual TLS.
+	ClientCertPEM string
+	ClientKeyPEM  string
+	// InsecureSkipVerify disables verification of the Cortex API's certificate. Only intended for development.
+	InsecureSkipVerify bool
+}
+
+// Enabled returns true if any option differs from Go's default TLS behavior.
+func (o TLSOptions) Enabled() bool {
+	return o.CACertFile != "" || o.CACertPEM != "" || o.ClientCertPEM != "" || o.ClientKeyPEM != "" || o.InsecureSkipVerify
+}
+
+// NewTLSConfig builds a tls.Config from the given options.
+func NewTLSConfig(opts TLSOptions) (*tls.Config, error) {
+	cfg := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: opts.InsecureSkipVerify, //nolint:gosec // explicitly opted into for development
+	}
+
+	if opts.CACertFile != "" || opts.CACertPEM != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if opts.CACertFile != "" {
+			pem, err := os.ReadFile(opts.CACertFile)
+			if err != nil {
+				return nil, fmt.Errorf("could not read CA certificate file %s: %w", opts.CACertFile, err)
+			}
+			if !pool.AppendCertsFromPEM(pem) {
+				return nil, fmt.Errorf("no valid PEM certificates found in CA certificate file %s", opts.CACertFile)
+			}
+		}
+		if opts.CACertPEM != "" && !pool.AppendCertsFromPEM([]byte(opts.CACertPEM)) {
+			return nil, errors.New("no valid PEM certificates found in CA certificate")
+		}
+		cfg.RootCAs = pool
+	}
+
+	if opts.ClientCertPEM != "" || opts.ClientKeyPEM != "" {
+		if opts.ClientCertPEM == "" || opts.ClientKeyPEM == "" {
+			return nil, errors.New("both a client certificate and a client key are required for mutual TLS")
+		}
+		cert, err := tls.X509KeyPair([]byte(opts.ClientCertPEM), []byte(opts.ClientKeyPEM))
+		if err != nil {
+			return nil, fmt.Errorf("could not load client certificate: %w", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,187 @@
+package cortex_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/stretchr/testify/assert"
+)
+
+var pingHandler = http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+	_, _ = w.Write([]byte(pingResponseJSON))
+})
+
+func serverCertPEM(ts *httptest.Server) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw}))
+}
+
+// generateClientCert returns a self-signed CA, and a PEM-encoded client certificate and key issued by it.
+func generateClientCert(t *testing.T) (*x509.Certificate, string, string) {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDer, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	assert.Nil(t, err)
+	ca, err := x509.ParseCertificate(caDer)
+	assert.Nil(t, err)
+
+	clientKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+	clientTemplate := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "test-client"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	clientDer, err := x509.CreateCertificate(rand.Reader, clientTemplate, ca, &clientKey.PublicKey, caKey)
+	assert.Nil(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(clientKey)
+	assert.Nil(t, err)
+
+	certPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: clientDer})
+	keyPem := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+	return ca, string(certPem), string(keyPem)
+}
+
+func pingWithTLS(t *testing.T, url string, opts cortex.TLSOptions) error {
+	tlsConfig, err := cortex.NewTLSConfig(opts)
+	assert.Nil(t, err, "could not build TLS config")
+	c, err := cortex.NewClient(
+		cortex.WithURL(url),
+		cortex.WithToken("test"),
+		cortex.WithTLSConfig(tlsConfig),
+	)
+	assert.Nil(t, err, "could not build client")
+	return c.Ping(context.Background())
+}
+
+func TestClientTLSUntrustedServer(t *testing.T) {
+	ts := httptest.NewTLSServer(pingHandler)
+	defer ts.Close()
+
+	c, err := cortex.NewClient(cortex.WithURL(ts.URL), cortex.WithToken("test"))
+	assert.Nil(t, err, "could not build client")
+
+	err = c.Ping(context.Background())
+	assert.ErrorContains(t, err, "certificate")
+}
+
+func TestClientTLSCustomCA(t *testing.T) {
+	ts := httptest.NewTLSServer(pingHandler)
+	defer ts.Close()
+
+	err := pingWithTLS(t, ts.URL, cortex.TLSOptions{CACertPEM: serverCertPEM(ts)})
+	assert.Nil(t, err, "expected server to be trusted with CA certificate PEM")
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	assert.Nil(t, os.WriteFile(caFile, []byte(serverCertPEM(ts)), 0600))
+	err = pingWithTLS(t, ts.URL, cortex.TLSOptions{CACertFile: caFile})
+	assert.Nil(t, err, "expected server to be trusted with CA certificate file")
+}
+
+func TestClientTLSInsecureSkipVerify(t *testing.T) {
+	ts := httptest.NewTLSServer(pingHandler)
+	defer ts.Close()
+
+	err := pingWithTLS(t, ts.URL, cortex.TLSOptions{InsecureSkipVerify: true})
+	assert.Nil(t, err, "expected verification to be skipped")
+}
+
+func TestClientMutualTLS(t *testing.T) {
+	ca, certPem, keyPem := generateClientCert(t)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(ca)
+
+	ts := httptest.NewUnstartedServer(pingHandler)
+	ts.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+	}
+	ts.StartTLS()
+	defer ts.Close()
+
+	err := pingWithTLS(t, ts.URL, cortex.TLSOptions{CACertPEM: serverCertPEM(ts)})
+	assert.NotNil(t, err, "expected handshake to fail without a client certificate")
+
+	err = pingWithTLS(t, ts.URL, cortex.TLSOptions{
+		CACertPEM:     serverCertPEM(ts),
+		ClientCertPEM: certPem,
+		ClientKeyPEM:  keyPem,
+	})
+	assert.Nil(t, err, "expected handshake to succeed with a client certificate")
+}
+
+func TestNewTLSConfigErrors(t *testing.T) {
+	_, certPem, _ := generateClientCert(t)
+
+	_, err := cortex.NewTLSConfig(cortex.TLSOptions{CACertPEM: "not a certificate"})
+	assert.ErrorContains(t, err, "no valid PEM certificates")
+
+	_, err = cortex.NewTLSConfig(cortex.TLSOptions{CACertFile: filepath.Join(t.TempDir(), "missing.pem")})
+	assert.ErrorContains(t, err, "could not read CA certificate file")
+
+	_, err = cortex.NewTLSConfig(cortex.TLSOptions{ClientCertPEM: certPem})
+	assert.ErrorContains(t, err, "both a client certificate and a client key are required")
+}
+
+func TestClientProxyURL(t *testing.T) {
+	var proxiedHost string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		proxiedHost = req.URL.Host
+		_, _ = w.Write([]byte(pingResponseJSON))
+	}))
+	defer proxy.Close()
+
+	c, err := cortex.NewClient(
+		cortex.WithURL("http://cortex.example.invalid"),
+		cortex.WithToken("test"),
+		cortex.WithProxyURL(proxy.URL),
+	)
+	assert.Nil(t, err, "could not build client")
+
+	err = c.Ping(context.Background())
+	assert.Nil(t, err, "expected request to be sent through the proxy")
+	assert.Equal(t, "cortex.example.invalid", proxiedHost)
+
+	_, err = cortex.NewClient(cortex.WithProxyURL("proxy.internal:3128"))
+	assert.NotNil(t, err, "expected proxy URL without a scheme to be rejected")
+}
+
+func TestClientWithHTTPClient(t *testing.T) {
+	ts := httptest.NewTLSServer(pingHandler)
+	defer ts.Close()
+
+	c, err := cortex.NewClient(
+		cortex.WithURL(ts.URL),
+		cortex.WithToken("test"),
+		cortex.WithHTTPClient(ts.Client()),
+	)
+	assert.Nil(t, err, "could not build client")
+
+	err = c.Ping(context.Background())
+	assert.Nil(t, err, "expected the given HTTP client to be used")
+}
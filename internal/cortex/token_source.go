@@ -0,0 +1,106 @@
+package cortex
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// TokenSource supplies the API token sent with each request.
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+/***********************************************************************************************************************
+ * Static
+ **********************************************************************************************************************/
+
+// StaticTokenSource always returns the same token.
+type StaticTokenSource string
+
+var _ TokenSource = StaticTokenSource("")
+
+func (s StaticTokenSource) Token(ctx context.Context) (string, error) {
+	return string(s), nil
+}
+
+/***********************************************************************************************************************
+ * File
+ **********************************************************************************************************************/
+
+// FileTokenSource reads the token from a file on every request, so that tokens rotated on disk (e.g. by a Vault agent)
+// are picked up without re-initializing the provider.
+type FileTokenSource struct {
+	Path string
+}
+
+var _ TokenSource = &FileTokenSource{}
+
+func (s *FileTokenSource) Token(ctx context.Context) (string, error) {
+	b, err := os.ReadFile(s.Path)
+	if err != nil {
+		return "", fmt.Errorf("could not read token file %s: %w", s.Path, err)
+	}
+	token := strings.TrimSpace(string(b))
+	if token == "" {
+		return "", fmt.Errorf("token file %s is empty", s.Path)
+	}
+	return token, nil
+}
+
+/***********************************************************************************************************************
+ * Command
+ **********************************************************************************************************************/
+
+// CommandTokenSource runs a shell command (e.g. a Vault or 1Password CLI invocation) and uses its trimmed standard
+// output as the token. The command is run on first use and its token is reused for the client's lifetime; failed runs
+// are not cached.
+type CommandTokenSource struct {
+	Command string
+
+	mu    sync.Mutex
+	token string
+}
+
+var _ TokenSource = &CommandTokenSource{}
+
+func (s *CommandTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token != "" {
+		return s.token, nil
+	}
+	token, err := s.run(ctx)
+	if err != nil {
+		return "", err
+	}
+	s.token = token
+	return token, nil
+}
+
+func (s *CommandTokenSource) run(ctx context.Context) (string, error) {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", s.Command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", s.Command)
+	}
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("token command failed: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	token := strings.TrimSpace(string(out))
+	if token == "" {
+		return "", errors.New("token command returned an empty token")
+	}
+	return token, nil
+}
@@ -0,0 +1,101 @@
+package cortex_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileTokenSourceRereadsFile(t *testing.T) {
+	var tokens []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		tokens = append(tokens, req.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(pingResponseJSON))
+	}))
+	defer ts.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	assert.Nil(t, os.WriteFile(tokenFile, []byte("first-token\n"), 0600))
+
+	c, err := cortex.NewClient(
+		cortex.WithURL(ts.URL),
+		cortex.WithTokenSource(&cortex.FileTokenSource{Path: tokenFile}),
+	)
+	assert.Nil(t, err, "could not build client")
+
+	assert.Nil(t, c.Ping(context.Background()))
+	assert.Nil(t, os.WriteFile(tokenFile, []byte("rotated-token"), 0600))
+	assert.Nil(t, c.Ping(context.Background()))
+
+	assert.Equal(t, []string{"Bearer first-token", "Bearer rotated-token"}, tokens)
+}
+
+func TestFileTokenSourceErrors(t *testing.T) {
+	dir := t.TempDir()
+
+	_, err := (&cortex.FileTokenSource{Path: filepath.Join(dir, "missing")}).Token(context.Background())
+	assert.ErrorContains(t, err, "could not read token file")
+
+	empty := filepath.Join(dir, "empty")
+	assert.Nil(t, os.WriteFile(empty, []byte("  \n"), 0600))
+	_, err = (&cortex.FileTokenSource{Path: empty}).Token(context.Background())
+	assert.ErrorContains(t, err, "is empty")
+}
+
+func TestCommandTokenSource(t *testing.T) {
+	counter := filepath.Join(t.TempDir(), "runs")
+	source := &cortex.CommandTokenSource{Command: "echo run >> " + counter + " && echo '  command-token  '"}
+
+	token, err := source.Token(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "command-token", token)
+
+	token, err = source.Token(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "command-token", token)
+
+	runs, err := os.ReadFile(counter)
+	assert.Nil(t, err)
+	assert.Equal(t, "run\n", string(runs), "expected the command to only run once")
+}
+
+func TestCommandTokenSourceErrors(t *testing.T) {
+	_, err := (&cortex.CommandTokenSource{Command: "echo oops >&2; exit 3"}).Token(context.Background())
+	assert.ErrorContains(t, err, "token command failed")
+	assert.ErrorContains(t, err, "oops")
+
+	_, err = (&cortex.CommandTokenSource{Command: "true"}).Token(context.Background())
+	assert.ErrorContains(t, err, "empty token")
+}
+
+func TestLoadProfile(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "config")
+	assert.Nil(t, os.WriteFile(configFile, []byte(`# Cortex CLI config
+[default]
+api_key = default-key
+base_url = https://api.getcortexapp.com
+
+[staging]
+api_key=staging-key
+base_url=https://cortex.staging.internal
+`), 0600))
+
+	profile, err := cortex.LoadProfile(configFile, cortex.DefaultProfile)
+	assert.Nil(t, err)
+	assert.Equal(t, "default-key", profile.ApiKey)
+	assert.Equal(t, "https://api.getcortexapp.com", profile.BaseUrl)
+
+	profile, err = cortex.LoadProfile(configFile, "staging")
+	assert.Nil(t, err)
+	assert.Equal(t, "staging-key", profile.ApiKey)
+	assert.Equal(t, "https://cortex.staging.internal", profile.BaseUrl)
+
+	_, err = cortex.LoadProfile(configFile, "production")
+	assert.ErrorContains(t, err, `profile "production" not found`)
+}
@@ -0,0 +1,95 @@
+package cortex
+
+import (
+	"context"
+	"errors"
+	"github.com/dghubble/sling"
+)
+
+type UsersClientInterface interface {
+	Get(ctx context.Context, email string) (User, error)
+	List(ctx context.Context, params *UserListParams) (*UsersResponse, error)
+}
+
+type UsersClient struct {
+	client *HttpClient
+}
+
+var _ UsersClientInterface = &UsersClient{}
+
+func (c *UsersClient) Client() *sling.Sling {
+	return c.client.Client()
+}
+
+/***********************************************************************************************************************
+ * Types
+ **********************************************************************************************************************/
+
+// User is a user of the Cortex workspace. Role is the tag of the user's role, and Teams the tags of the teams they
+// are a member of. Users who have left the workspace are not returned.
+type User struct {
+	Id    string   `json:"id"`
+	Email string   `json:"email"`
+	Name  string   `json:"name"`
+	Role  string   `json:"role"`
+	Teams []string `json:"teams"`
+}
+
+/***********************************************************************************************************************
+ * GET /api/v1/users/:email
+ **********************************************************************************************************************/
+
+func (c *UsersClient) Get(ctx context.Context, email string) (User, error) {
+	user := User{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Get(Route("users", email)), &user, &apiError)
+	if err != nil {
+		return user, errors.New("could not get user: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return user, err
+	}
+
+	return user, nil
+}
+
+/***********************************************************************************************************************
+ * GET /api/v1/users
+ **********************************************************************************************************************/
+
+// UserListParams are the query parameters for the GET /v1/users endpoint. Email and Name match any part of the
+// user's email or name, case-insensitively.
+type UserListParams struct {
+	Email    string `url:"email,omitempty"`
+	Name     string `url:"name,omitempty"`
+	Page     int    `url:"page,omitempty"`
+	PageSize int    `url:"pageSize,omitempty"`
+}
+
+// UsersResponse is a page of users.
+type UsersResponse struct {
+	Users      []User `json:"users"`
+	Page       int    `json:"page"`
+	TotalPages int    `json:"totalPages"`
+	Total      int    `json:"total"`
+}
+
+func (c *UsersClient) List(ctx context.Context, params *UserListParams) (*UsersResponse, error) {
+	usersResponse := &UsersResponse{}
+	apiError := &ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Get(Route("users", "")).QueryStruct(params), usersResponse, apiError)
+	if err != nil {
+		return nil, errors.New("could not get users: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, apiError)
+	if err != nil {
+		return nil, err
+	}
+
+	return usersResponse, nil
+}
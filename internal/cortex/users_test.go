@@ -0,0 +1,41 @@
+package cortex_test
+
+import (
+	"context"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var testUser = cortex.User{
+	Id:    "u-1",
+	Email: "owner@example.com",
+	Name:  "Owner",
+	Role:  "USER",
+	Teams: []string{"platform"},
+}
+
+func TestGetUser(t *testing.T) {
+	c, teardown, err := setupClient(cortex.Route("users", testUser.Email), testUser, AssertRequestMethod(t, "GET"))
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.Users().Get(context.Background(), testUser.Email)
+	assert.Nil(t, err, "error retrieving a user")
+	assert.Equal(t, testUser, res)
+}
+
+func TestListUsers(t *testing.T) {
+	c, teardown, err := setupClient(
+		cortex.Route("users", ""),
+		cortex.UsersResponse{Users: []cortex.User{testUser}, Page: 0, TotalPages: 1, Total: 1},
+		AssertRequestMethod(t, "GET"),
+		AssertRequestURI(t, cortex.Route("users", "")+"?email=example.com&pageSize=10"),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.Users().List(context.Background(), &cortex.UserListParams{Email: "example.com", PageSize: 10})
+	assert.Nil(t, err, "error listing users")
+	assert.Equal(t, []cortex.User{testUser}, res.Users)
+}
@@ -0,0 +1,191 @@
+package cortex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/dghubble/sling"
+	"gopkg.in/yaml.v3"
+	"strings"
+)
+
+type WorkflowsClientInterface interface {
+	Get(ctx context.Context, tag string) (Workflow, error)
+	GetDescriptor(ctx context.Context, tag string) (map[string]interface{}, error)
+	Upsert(ctx context.Context, workflow Workflow) (Workflow, error)
+	UpsertDescriptor(ctx context.Context, descriptor string) error
+	Delete(ctx context.Context, tag string) error
+}
+
+type WorkflowsClient struct {
+	client *HttpClient
+}
+
+var _ WorkflowsClientInterface = &WorkflowsClient{}
+
+func (c *WorkflowsClient) Client() *sling.Sling {
+	return c.client.Client()
+}
+
+func (c *WorkflowsClient) YamlClient() *sling.Sling {
+	return c.client.YamlClient()
+}
+
+/***********************************************************************************************************************
+ * Types
+ **********************************************************************************************************************/
+
+const (
+	WorkflowFilterTypeGlobal = "GLOBAL"
+	WorkflowFilterTypeEntity = "ENTITY"
+
+	WorkflowActionTypeHttpRequest    = "HTTP_REQUEST"
+	WorkflowActionTypeSlack          = "SLACK"
+	WorkflowActionTypeManualApproval = "MANUAL_APPROVAL"
+	WorkflowActionTypeScaffolder     = "SCAFFOLDER"
+)
+
+// Workflow is the descriptor of a Cortex automation workflow.
+type Workflow struct {
+	Tag                 string           `json:"tag" yaml:"tag"`
+	Name                string           `json:"name" yaml:"name"`
+	Description         string           `json:"description,omitempty" yaml:"description,omitempty"`
+	IsDraft             bool             `json:"isDraft" yaml:"isDraft"`
+	Filter              WorkflowFilter   `json:"filter" yaml:"filter"`
+	RunResponseTemplate string           `json:"runResponseTemplate,omitempty" yaml:"runResponseTemplate,omitempty"`
+	Actions             []WorkflowAction `json:"actions" yaml:"actions"`
+}
+
+func (w *Workflow) ToYaml() (string, error) {
+	bytes, err := yaml.Marshal(w)
+	if err != nil {
+		return "", errors.New("could not marshal yaml: " + err.Error())
+	}
+	return string(bytes), nil
+}
+
+// WorkflowFilter restricts which entities a workflow can be run against. GLOBAL workflows aren't run against an entity.
+type WorkflowFilter struct {
+	Type         string                `json:"type" yaml:"type"`
+	EntityFilter *WorkflowEntityFilter `json:"entityFilter,omitempty" yaml:"entityFilter,omitempty"`
+}
+
+type WorkflowEntityFilter struct {
+	EntityTypes []string `json:"entityTypes" yaml:"entityTypes"`
+}
+
+// WorkflowAction is a single step of a workflow. Schema holds the type-specific configuration of the step, keyed by
+// "type".
+type WorkflowAction struct {
+	Name            string                 `json:"name" yaml:"name"`
+	Slug            string                 `json:"slug" yaml:"slug"`
+	Schema          map[string]interface{} `json:"schema" yaml:"schema"`
+	OutgoingActions []string               `json:"outgoingActions" yaml:"outgoingActions"`
+	IsRootAction    bool                   `json:"isRootAction" yaml:"isRootAction"`
+}
+
+func (a *WorkflowAction) Type() string {
+	return MapFetchToString(a.Schema, "type")
+}
+
+/***********************************************************************************************************************
+ * GET /api/v1/workflows/:tag
+ **********************************************************************************************************************/
+
+func (c *WorkflowsClient) Get(ctx context.Context, tag string) (Workflow, error) {
+	workflow := Workflow{}
+	apiError := ApiError{}
+
+	cl := c.YamlClient().Set("Accept", "application/yaml").Get(Route("workflows", tag))
+	response, err := c.client.receive(ctx, cl, &workflow, &apiError)
+	if err != nil {
+		return workflow, errors.New("could not get workflow: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return workflow, err
+	}
+
+	return workflow, nil
+}
+
+// GetDescriptor returns the workflow descriptor as-is, including any fields that Workflow doesn't model.
+func (c *WorkflowsClient) GetDescriptor(ctx context.Context, tag string) (map[string]interface{}, error) {
+	descriptor := map[string]interface{}{}
+	apiError := ApiError{}
+
+	cl := c.YamlClient().Set("Accept", "application/yaml").Get(Route("workflows", tag))
+	response, err := c.client.receive(ctx, cl, &descriptor, &apiError)
+	if err != nil {
+		return nil, errors.New("could not get workflow descriptor: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return nil, err
+	}
+
+	return descriptor, nil
+}
+
+/***********************************************************************************************************************
+ * POST /api/v1/workflows
+ **********************************************************************************************************************/
+
+type UpsertWorkflowResponse struct{}
+
+func (c *WorkflowsClient) Upsert(ctx context.Context, workflow Workflow) (Workflow, error) {
+	// The API accepts the descriptor as YAML, so we need to marshal it first.
+	yamlBody, err := workflow.ToYaml()
+	if err != nil {
+		return Workflow{}, err
+	}
+
+	err = c.UpsertDescriptor(ctx, yamlBody)
+	if err != nil {
+		return Workflow{}, err
+	}
+
+	// re-fetch the workflow, since it's not returned here
+	return c.Get(ctx, workflow.Tag)
+}
+
+// UpsertDescriptor creates or updates a workflow from a YAML descriptor.
+func (c *WorkflowsClient) UpsertDescriptor(ctx context.Context, descriptor string) error {
+	upsertResponse := UpsertWorkflowResponse{}
+	apiError := ApiError{}
+
+	logCtx := withRequestLog(ctx, "Upserting workflow descriptor", map[string]interface{}{
+		"body": redactYaml(descriptor),
+	})
+	cl := c.Client().
+		Set("Content-Type", "application/yaml;charset=UTF-8").
+		Set("Accept", "application/json").
+		Post(Route("workflows", "")).
+		Body(strings.NewReader(descriptor))
+	response, err := c.client.receive(logCtx, cl, &upsertResponse, &apiError)
+	if err != nil {
+		return fmt.Errorf("could not upsert workflow: %w", err)
+	}
+
+	return c.client.handleResponseStatus(response, &apiError)
+}
+
+/***********************************************************************************************************************
+ * DELETE /api/v1/workflows/:tag
+ **********************************************************************************************************************/
+
+type DeleteWorkflowResponse struct{}
+
+func (c *WorkflowsClient) Delete(ctx context.Context, tag string) error {
+	deleteResponse := DeleteWorkflowResponse{}
+	apiError := ApiError{}
+
+	response, err := c.client.receive(ctx, c.Client().Delete(Route("workflows", tag)), &deleteResponse, &apiError)
+	if err != nil {
+		return errors.New("could not delete workflow: " + err.Error())
+	}
+
+	return c.client.handleResponseStatus(response, &apiError)
+}
@@ -0,0 +1,82 @@
+package cortex_test
+
+import (
+	"context"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+	"net/http"
+	"testing"
+)
+
+var testWorkflow = cortex.Workflow{
+	Tag:     "test-workflow",
+	Name:    "Test Workflow",
+	IsDraft: true,
+	Filter:  cortex.WorkflowFilter{Type: cortex.WorkflowFilterTypeGlobal},
+	Actions: []cortex.WorkflowAction{
+		{
+			Name:            "Notify",
+			Slug:            "notify",
+			Schema:          map[string]interface{}{"type": cortex.WorkflowActionTypeSlack, "channel": "#deploys", "message": "Hello"},
+			OutgoingActions: []string{},
+			IsRootAction:    true,
+		},
+	},
+}
+
+func TestGetWorkflow(t *testing.T) {
+	c, teardown, err := setupYamlClient(cortex.Route("workflows", testWorkflow.Tag), testWorkflow, AssertRequestMethod(t, "GET"))
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.Workflows().Get(context.Background(), testWorkflow.Tag)
+	assert.Nil(t, err, "error retrieving a workflow")
+	assert.Equal(t, testWorkflow, res)
+	assert.Equal(t, cortex.WorkflowActionTypeSlack, res.Actions[0].Type())
+}
+
+func TestGetWorkflowDescriptor(t *testing.T) {
+	c, teardown, err := setupYamlClient(cortex.Route("workflows", testWorkflow.Tag), testWorkflow, AssertRequestMethod(t, "GET"))
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.Workflows().GetDescriptor(context.Background(), testWorkflow.Tag)
+	assert.Nil(t, err, "error retrieving a workflow descriptor")
+	assert.Equal(t, "test-workflow", res["tag"])
+	assert.Len(t, res["actions"], 1)
+}
+
+func TestUpsertWorkflow(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc(cortex.Route("workflows", ""), func(w http.ResponseWriter, req *http.Request) {
+		defer req.Body.Close()
+		AssertRequestMethod(t, "POST")(req)
+		AssertRequestBodyYaml(t, testWorkflow)(req)
+		_, _ = w.Write([]byte(`{}`))
+	})
+	mux.HandleFunc(cortex.Route("workflows", testWorkflow.Tag), func(w http.ResponseWriter, req *http.Request) {
+		AssertRequestMethod(t, "GET")(req)
+		_ = yaml.NewEncoder(w).Encode(testWorkflow)
+	})
+	c, teardown, err := buildClient(mux)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.Workflows().Upsert(context.Background(), testWorkflow)
+	assert.Nil(t, err, "error upserting a workflow")
+	assert.Equal(t, testWorkflow, res)
+}
+
+func TestDeleteWorkflow(t *testing.T) {
+	c, teardown, err := setupClient(
+		cortex.Route("workflows", testWorkflow.Tag),
+		cortex.DeleteWorkflowResponse{},
+		AssertRequestMethod(t, "DELETE"),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	err = c.Workflows().Delete(context.Background(), testWorkflow.Tag)
+	assert.Nil(t, err, "error deleting a workflow")
+}
@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &ApiKeyEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &ApiKeyEphemeralResource{}
+
+func NewApiKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &ApiKeyEphemeralResource{}
+}
+
+// ApiKeyEphemeralResource defines the ephemeral resource implementation. It reads an API key's secret at apply time,
+// so that the secret is never stored in plan or state files.
+type ApiKeyEphemeralResource struct {
+	client *cortex.HttpClient
+}
+
+// ApiKeyEphemeralResourceModel describes the ephemeral resource data model.
+type ApiKeyEphemeralResourceModel struct {
+	Id     types.String `tfsdk:"id"`
+	Secret types.String `tfsdk:"secret"`
+}
+
+func (r *ApiKeyEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_key"
+}
+
+func (r *ApiKeyEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "API Key ephemeral resource - reads the secret of an API key created by the `cortex_api_key` resource. Requires Terraform 1.10 or later.",
+
+		Attributes: map[string]schema.Attribute{
+			// Required
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the API key",
+				Required:            true,
+			},
+
+			// Computed
+			"secret": schema.StringAttribute{
+				MarkdownDescription: "Secret of the API key, used as the bearer token of Cortex API requests",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *ApiKeyEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cortex.HttpClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ApiKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ApiKeyEphemeralResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secret, err := r.client.ApiKeys().GetSecret(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read API key secret, got error: %s", err))
+		return
+	}
+
+	data.Secret = types.StringValue(secret.Secret)
+
+	// Save data into the ephemeral result
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+/***********************************************************************************************************************
+ * Models
+ **********************************************************************************************************************/
+
+// ApiKeyResourceModel describes the API key data model within Terraform. The key's secret is deliberately not part
+// of it; it is only available from the cortex_api_key ephemeral resource.
+type ApiKeyResourceModel struct {
+	Id             types.String   `tfsdk:"id"`
+	Name           types.String   `tfsdk:"name"`
+	Description    types.String   `tfsdk:"description"`
+	Roles          []types.String `tfsdk:"roles"`
+	ExpirationDate types.String   `tfsdk:"expiration_date"`
+	CreatedDate    types.String   `tfsdk:"created_date"`
+}
+
+func NewApiKeyResourceModel() ApiKeyResourceModel {
+	return ApiKeyResourceModel{}
+}
+
+func (o *ApiKeyResourceModel) ToApiModel() cortex.ApiKey {
+	entity := cortex.ApiKey{
+		Id:             o.Id.ValueString(),
+		Name:           o.Name.ValueString(),
+		Description:    o.Description.ValueString(),
+		Roles:          make([]string, len(o.Roles)),
+		ExpirationDate: o.ExpirationDate.ValueString(),
+	}
+	for i, role := range o.Roles {
+		entity.Roles[i] = role.ValueString()
+	}
+	return entity
+}
+
+func (o *ApiKeyResourceModel) FromApiModel(entity cortex.ApiKey) {
+	o.Id = types.StringValue(entity.Id)
+	o.Name = types.StringValue(entity.Name)
+	o.Description = stringValueOrNull(entity.Description)
+	o.ExpirationDate = timestampValue(o.ExpirationDate, entity.ExpirationDate)
+	o.CreatedDate = stringValueOrNull(entity.CreatedDate)
+
+	o.Roles = make([]types.String, len(entity.Roles))
+	for i, role := range entity.Roles {
+		o.Roles[i] = types.StringValue(role)
+	}
+}
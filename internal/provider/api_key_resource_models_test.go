@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApiKeyResourceModelRoundTrip(t *testing.T) {
+	entity := cortex.ApiKey{
+		Id:             "ak-1",
+		Name:           "ci-pipeline",
+		Roles:          []string{"READ_ONLY", "deployer"},
+		ExpirationDate: "2027-01-01T00:00:00Z",
+	}
+
+	data := NewApiKeyResourceModel()
+	data.FromApiModel(entity)
+	assert.True(t, data.Description.IsNull())
+	assert.True(t, data.CreatedDate.IsNull())
+	assert.Equal(t, entity, data.ToApiModel())
+}
+
+func TestApiKeyResourceModelExpirationDate(t *testing.T) {
+	entity := cortex.ApiKey{
+		Id:             "ak-1",
+		Name:           "ci-pipeline",
+		Roles:          []string{"READ_ONLY"},
+		ExpirationDate: "2027-01-01T00:00:00.000Z",
+	}
+
+	tests := []struct {
+		name                   string
+		expirationDate         types.String
+		expectedExpirationDate string
+	}{
+		{name: "imported expiration date", expirationDate: types.StringNull(), expectedExpirationDate: "2027-01-01T00:00:00.000Z"},
+		{name: "same instant keeps configured format", expirationDate: types.StringValue("2027-01-01T02:00:00+02:00"), expectedExpirationDate: "2027-01-01T02:00:00+02:00"},
+		{name: "different instant", expirationDate: types.StringValue("2027-06-01T00:00:00Z"), expectedExpirationDate: "2027-01-01T00:00:00.000Z"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := NewApiKeyResourceModel()
+			data.ExpirationDate = tt.expirationDate
+
+			data.FromApiModel(entity)
+			assert.Equal(t, tt.expectedExpirationDate, data.ExpirationDate.ValueString())
+		})
+	}
+}
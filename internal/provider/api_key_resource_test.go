@@ -0,0 +1,56 @@
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccApiKeyResource(t *testing.T) {
+	resourceName := "cortex_api_key.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccApiKeyResourceConfig("Used by acceptance tests", `["READ_ONLY"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "terraform-acceptance-test"),
+					resource.TestCheckResourceAttr(resourceName, "roles.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "expiration_date", "2099-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckNoResourceAttr(resourceName, "secret"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccApiKeyResourceConfig("Updated by acceptance tests", `["READ_ONLY", "USER"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "Updated by acceptance tests"),
+					resource.TestCheckResourceAttr(resourceName, "roles.#", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccApiKeyResourceConfig(description string, roles string) string {
+	return fmt.Sprintf(`
+resource "cortex_api_key" "test" {
+  name            = "terraform-acceptance-test"
+  description     = %[1]q
+  roles           = %[2]s
+  expiration_date = "2099-01-01T00:00:00Z"
+}
+`, description, roles)
+}
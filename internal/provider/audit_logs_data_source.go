@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AuditLogsDataSource{}
+
+func NewAuditLogsDataSource() datasource.DataSource {
+	return &AuditLogsDataSource{}
+}
+
+// AuditLogsDataSource defines the data source implementation.
+type AuditLogsDataSource struct {
+	client *cortex.HttpClient
+}
+
+// AuditLogsDataSourceModel describes the data source data model.
+type AuditLogsDataSourceModel struct {
+	Id         types.String                  `tfsdk:"id"`
+	StartTime  types.String                  `tfsdk:"start_time"`
+	EndTime    types.String                  `tfsdk:"end_time"`
+	ActorEmail types.String                  `tfsdk:"actor_email"`
+	ObjectType types.String                  `tfsdk:"object_type"`
+	ObjectTag  types.String                  `tfsdk:"object_tag"`
+	Logs       []AuditLogDataSourceItemModel `tfsdk:"logs"`
+}
+
+// AuditLogDataSourceItemModel is a single change made in Cortex.
+type AuditLogDataSourceItemModel struct {
+	Timestamp  types.String `tfsdk:"timestamp"`
+	Action     types.String `tfsdk:"action"`
+	ActorEmail types.String `tfsdk:"actor_email"`
+	ActorName  types.String `tfsdk:"actor_name"`
+	ObjectType types.String `tfsdk:"object_type"`
+	ObjectTag  types.String `tfsdk:"object_tag"`
+}
+
+func (o *AuditLogDataSourceItemModel) FromApiModel(log cortex.AuditLog) {
+	o.Timestamp = types.StringValue(log.Timestamp)
+	o.Action = types.StringValue(log.Action)
+	o.ActorEmail = stringValueOrNull(log.ActorEmail)
+	o.ActorName = stringValueOrNull(log.ActorName)
+	o.ObjectType = types.StringValue(log.ObjectType)
+	o.ObjectTag = stringValueOrNull(log.ObjectTag)
+}
+
+func (d *AuditLogsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit_logs"
+}
+
+func (d *AuditLogsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	rfc3339Validators := []validator.String{
+		stringvalidator.RegexMatches(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:\d{2})$`), "must be an RFC 3339 timestamp"),
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Audit Logs data source - returns the changes made in Cortex, most recent first, for attributing changes made outside of Terraform",
+
+		Attributes: map[string]schema.Attribute{
+			// Optional
+			"start_time": schema.StringAttribute{
+				MarkdownDescription: "Only return changes made at or after this RFC 3339 timestamp, such as `2026-10-01T00:00:00Z`",
+				Optional:            true,
+				Validators:          rfc3339Validators,
+			},
+			"end_time": schema.StringAttribute{
+				MarkdownDescription: "Only return changes made before this RFC 3339 timestamp",
+				Optional:            true,
+				Validators:          rfc3339Validators,
+			},
+			"actor_email": schema.StringAttribute{
+				MarkdownDescription: "Only return changes made by the user with this email",
+				Optional:            true,
+			},
+			"object_type": schema.StringAttribute{
+				MarkdownDescription: "Only return changes to objects of this type, for example `SCORECARD` or `CATALOG_ENTITY`",
+				Optional:            true,
+			},
+			"object_tag": schema.StringAttribute{
+				MarkdownDescription: "Only return changes to the object with this tag",
+				Optional:            true,
+			},
+
+			// Computed
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Internal identifier for this data source",
+				Computed:            true,
+			},
+			"logs": schema.ListNestedAttribute{
+				MarkdownDescription: "Matching changes, most recent first",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"timestamp": schema.StringAttribute{
+							MarkdownDescription: "When the change was made, in RFC 3339 format",
+							Computed:            true,
+						},
+						"action": schema.StringAttribute{
+							MarkdownDescription: "Kind of change: `CREATE`, `UPDATE` or `DELETE`",
+							Computed:            true,
+						},
+						"actor_email": schema.StringAttribute{
+							MarkdownDescription: "Email of the user who made the change",
+							Computed:            true,
+						},
+						"actor_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the user who made the change; empty for changes made with an API key",
+							Computed:            true,
+						},
+						"object_type": schema.StringAttribute{
+							MarkdownDescription: "Type of the changed object",
+							Computed:            true,
+						},
+						"object_tag": schema.StringAttribute{
+							MarkdownDescription: "Tag of the changed object",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AuditLogsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cortex.HttpClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *AuditLogsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AuditLogsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &cortex.AuditLogListParams{
+		StartTime:  data.StartTime.ValueString(),
+		EndTime:    data.EndTime.ValueString(),
+		ActorEmail: data.ActorEmail.ValueString(),
+		ObjectType: data.ObjectType.ValueString(),
+		ObjectTag:  data.ObjectTag.ValueString(),
+	}
+
+	logs, err := d.client.AuditLogs().ListAll(ctx, params)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read audit logs, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue("audit_logs")
+	data.Logs = make([]AuditLogDataSourceItemModel, len(logs))
+	for i, log := range logs {
+		data.Logs[i].FromApiModel(log)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
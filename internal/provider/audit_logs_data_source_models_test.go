@@ -0,0 +1,25 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditLogDataSourceItemModelFromApiModel(t *testing.T) {
+	item := AuditLogDataSourceItemModel{}
+	item.FromApiModel(cortex.AuditLog{
+		Timestamp:  "2026-10-01T00:00:00Z",
+		Action:     cortex.AuditLogActionCreate,
+		ActorEmail: "someone@example.com",
+		ObjectType: "SCORECARD",
+		ObjectTag:  "dora",
+	})
+	assert.Equal(t, "2026-10-01T00:00:00Z", item.Timestamp.ValueString())
+	assert.Equal(t, "CREATE", item.Action.ValueString())
+	assert.Equal(t, "someone@example.com", item.ActorEmail.ValueString())
+	assert.True(t, item.ActorName.IsNull(), "changes made with an API key have no actor name")
+	assert.Equal(t, "SCORECARD", item.ObjectType.ValueString())
+	assert.Equal(t, "dora", item.ObjectTag.ValueString())
+}
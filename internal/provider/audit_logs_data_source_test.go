@@ -0,0 +1,34 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAuditLogsDataSource(t *testing.T) {
+	recordName := "data.cortex_audit_logs.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing - changes to scorecards since the start of 2026
+			{
+				Config: testAccAuditLogsDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(recordName, "id"),
+					resource.TestCheckResourceAttrSet(recordName, "logs.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAuditLogsDataSourceConfig() string {
+	return `
+data "cortex_audit_logs" "test" {
+  start_time  = "2026-01-01T00:00:00Z"
+  object_type = "SCORECARD"
+}
+`
+}
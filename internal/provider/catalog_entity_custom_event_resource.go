@@ -0,0 +1,252 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CatalogEntityCustomEventResource{}
+var _ resource.ResourceWithImportState = &CatalogEntityCustomEventResource{}
+
+func NewCatalogEntityCustomEventResource() resource.Resource {
+	return &CatalogEntityCustomEventResource{}
+}
+
+/***********************************************************************************************************************
+ * Types
+ **********************************************************************************************************************/
+
+// CatalogEntityCustomEventResource defines the resource implementation.
+type CatalogEntityCustomEventResource struct {
+	client *cortex.HttpClient
+}
+
+/***********************************************************************************************************************
+ * Schema
+ **********************************************************************************************************************/
+
+func (r *CatalogEntityCustomEventResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// Events are points on the entity's timeline, so every attribute describing the event records a new one when changed.
+	requiresReplace := []planmodifier.String{
+		stringplanmodifier.RequiresReplace(),
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Catalog Entity Custom Event. Adds an event to a catalog entity's timeline. Changing any attribute other than `keep_on_destroy` records a new event.",
+
+		Attributes: map[string]schema.Attribute{
+			// Required attributes
+			"entity_tag": schema.StringAttribute{
+				MarkdownDescription: "Tag of the catalog entity the event belongs to.",
+				Required:            true,
+				PlanModifiers:       requiresReplace,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the event, such as `DATABASE_FAILOVER`. Used to filter events on the timeline.",
+				Required:            true,
+				PlanModifiers:       requiresReplace,
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "Title of the event.",
+				Required:            true,
+				PlanModifiers:       requiresReplace,
+			},
+
+			// Optional attributes
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the event.",
+				Optional:            true,
+				PlanModifiers:       requiresReplace,
+			},
+			"timestamp": schema.StringAttribute{
+				MarkdownDescription: "When the event happened, in RFC 3339 format. Defaults to when the event is recorded.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "URL with details of the event, such as a link to the Terraform run.",
+				Optional:            true,
+				PlanModifiers:       requiresReplace,
+			},
+			"custom_data": schema.StringAttribute{
+				MarkdownDescription: "Custom data for the event, in JSON format in a string. (Use the `jsonencode` function to convert a JSON object to a string.)",
+				Optional:            true,
+				PlanModifiers:       requiresReplace,
+			},
+			"keep_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Whether to keep the event in Cortex when the resource is destroyed or replaced, so that the entity's timeline is preserved when recording a new event. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+
+			// Computed attributes
+			"id": schema.StringAttribute{
+				MarkdownDescription: "UUID of the event.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+/***********************************************************************************************************************
+ * Methods
+ **********************************************************************************************************************/
+
+func (r *CatalogEntityCustomEventResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_catalog_entity_custom_event"
+}
+
+func (r *CatalogEntityCustomEventResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cortex.HttpClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CatalogEntityCustomEventResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	data := NewCatalogEntityCustomEventResourceModel()
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Issue API request
+	entity, err := r.client.CustomEvents().Get(ctx, data.EntityTag.ValueString(), data.Id.ValueString())
+	if err != nil {
+		if errors.Is(err, cortex.ApiErrorNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read custom event %s, got error: %s", data.Id.ValueString(), err))
+		return
+	}
+
+	// Imported events have no configured keep_on_destroy.
+	if data.KeepOnDestroy.IsNull() {
+		data.KeepOnDestroy = types.BoolValue(true)
+	}
+
+	// Map data from the API response to the model
+	data.FromApiModel(&resp.Diagnostics, data.EntityTag.ValueString(), entity)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CatalogEntityCustomEventResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	data := NewCatalogEntityCustomEventResourceModel()
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientEntity := data.ToApiModel(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// The API requires a timestamp, so default to now.
+	if clientEntity.Timestamp == "" {
+		clientEntity.Timestamp = time.Now().UTC().Format(time.RFC3339)
+	}
+
+	entity, err := r.client.CustomEvents().Create(ctx, data.EntityTag.ValueString(), clientEntity.ToCreateRequest())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create custom event, got error: %s", err))
+		return
+	}
+
+	data.FromApiModel(&resp.Diagnostics, data.EntityTag.ValueString(), entity)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update Only changes keep_on_destroy, which is not sent to the API; every other attribute forces replacement.
+func (r *CatalogEntityCustomEventResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	data := NewCatalogEntityCustomEventResourceModel()
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CatalogEntityCustomEventResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	data := NewCatalogEntityCustomEventResourceModel()
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.KeepOnDestroy.ValueBool() {
+		return
+	}
+
+	err := r.client.CustomEvents().Delete(ctx, data.EntityTag.ValueString(), data.Id.ValueString())
+	if err != nil && !errors.Is(err, cortex.ApiErrorNotFound) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete custom event, got error: %s", err))
+		return
+	}
+}
+
+func (r *CatalogEntityCustomEventResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ":")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: entity_tag:uuid. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("entity_tag"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+}
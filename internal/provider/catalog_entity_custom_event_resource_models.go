@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+/***********************************************************************************************************************
+ * Models
+ **********************************************************************************************************************/
+
+// CatalogEntityCustomEventResourceModel describes the catalog entity custom event data model within Terraform.
+type CatalogEntityCustomEventResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	EntityTag     types.String `tfsdk:"entity_tag"`
+	Type          types.String `tfsdk:"type"`
+	Title         types.String `tfsdk:"title"`
+	Description   types.String `tfsdk:"description"`
+	Timestamp     types.String `tfsdk:"timestamp"`
+	Url           types.String `tfsdk:"url"`
+	CustomData    types.String `tfsdk:"custom_data"`
+	KeepOnDestroy types.Bool   `tfsdk:"keep_on_destroy"`
+}
+
+func NewCatalogEntityCustomEventResourceModel() CatalogEntityCustomEventResourceModel {
+	return CatalogEntityCustomEventResourceModel{}
+}
+
+func (o *CatalogEntityCustomEventResourceModel) ToApiModel(diagnostics *diag.Diagnostics) cortex.CustomEvent {
+	entity := cortex.CustomEvent{
+		Type:        o.Type.ValueString(),
+		Title:       o.Title.ValueString(),
+		Description: o.Description.ValueString(),
+		Timestamp:   o.Timestamp.ValueString(),
+		Url:         o.Url.ValueString(),
+	}
+	if !o.CustomData.IsNull() && !o.CustomData.IsUnknown() && o.CustomData.ValueString() != "" {
+		err := json.Unmarshal([]byte(o.CustomData.ValueString()), &entity.CustomData)
+		if err != nil {
+			diagnostics.AddError("error parsing custom event custom data", fmt.Sprintf("%+v", err))
+		}
+	}
+	return entity
+}
+
+// FromApiModel maps the API event onto the model. KeepOnDestroy only affects how the resource is destroyed, so it is
+// left as configured.
+func (o *CatalogEntityCustomEventResourceModel) FromApiModel(diagnostics *diag.Diagnostics, entityTag string, entity cortex.CustomEvent) {
+	o.Id = types.StringValue(entity.Uuid)
+	o.EntityTag = types.StringValue(entityTag)
+	o.Type = types.StringValue(entity.Type)
+	o.Title = types.StringValue(entity.Title)
+	o.Description = stringValueOrNull(entity.Description)
+	o.Timestamp = timestampValue(o.Timestamp, entity.Timestamp)
+	o.Url = stringValueOrNull(entity.Url)
+	o.CustomData = customDataValue(diagnostics, entity.CustomData)
+}
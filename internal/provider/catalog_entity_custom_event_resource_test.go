@@ -0,0 +1,81 @@
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+type testCatalogEntityCustomEventResource struct {
+	EntityTag string
+	Title     string
+}
+
+func (t *testCatalogEntityCustomEventResource) ResourceFullName() string {
+	return t.ResourceType() + ".test"
+}
+
+func (t *testCatalogEntityCustomEventResource) ResourceType() string {
+	return "cortex_catalog_entity_custom_event"
+}
+
+func (t *testCatalogEntityCustomEventResource) ToTerraform() string {
+	return fmt.Sprintf(`
+resource %[1]q "test" {
+  entity_tag      = %[2]q
+  type            = "TERRAFORM_ACCEPTANCE"
+  title           = %[3]q
+  description     = "Recorded by the Terraform acceptance tests"
+  url             = "https://example.com/runs/1"
+  custom_data     = jsonencode({ "pipeline" : "acceptance" })
+  keep_on_destroy = false
+}`, t.ResourceType(), t.EntityTag, t.Title)
+}
+
+func TestAccCatalogEntityCustomEventResource(t *testing.T) {
+	stub := testCatalogEntityCustomEventResource{
+		EntityTag: "manual-test",
+		Title:     "Database failover",
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: stub.ToTerraform(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(stub.ResourceFullName(), "id"),
+					resource.TestCheckResourceAttrSet(stub.ResourceFullName(), "timestamp"),
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "type", "TERRAFORM_ACCEPTANCE"),
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "custom_data", `{"pipeline":"acceptance"}`),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: stub.ResourceFullName(),
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources[stub.ResourceFullName()]
+					return rs.Primary.Attributes["entity_tag"] + ":" + rs.Primary.ID, nil
+				},
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"keep_on_destroy"},
+			},
+			// Replace testing
+			{
+				Config: func() string {
+					updated := stub
+					updated.Title = "Database failover completed"
+					return updated.ToTerraform()
+				}(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "title", "Database failover completed"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
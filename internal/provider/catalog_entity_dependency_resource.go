@@ -0,0 +1,266 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CatalogEntityDependencyResource{}
+var _ resource.ResourceWithImportState = &CatalogEntityDependencyResource{}
+
+func NewCatalogEntityDependencyResource() resource.Resource {
+	return &CatalogEntityDependencyResource{}
+}
+
+func NewCatalogEntityDependencyEdgeResourceModel() CatalogEntityDependencyEdgeResourceModel {
+	return CatalogEntityDependencyEdgeResourceModel{}
+}
+
+/***********************************************************************************************************************
+ * Types
+ **********************************************************************************************************************/
+
+// CatalogEntityDependencyResource defines the resource implementation.
+type CatalogEntityDependencyResource struct {
+	client *cortex.HttpClient
+}
+
+/***********************************************************************************************************************
+ * Schema
+ **********************************************************************************************************************/
+
+func (r *CatalogEntityDependencyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Catalog Entity Dependency. Declares that one catalog entity depends on another, independently of either entity's definition. Do not also declare the same dependency in the caller's `cortex_catalog_entity.dependencies`.",
+
+		Attributes: map[string]schema.Attribute{
+			// Required attributes
+			"caller_tag": schema.StringAttribute{
+				MarkdownDescription: "Tag of the entity that depends on the callee.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"callee_tag": schema.StringAttribute{
+				MarkdownDescription: "Tag of the entity that is depended on.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Optional attributes
+			"method": schema.StringAttribute{
+				MarkdownDescription: "HTTP method if depending on a specific endpoint of the callee. Requires `path`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("GET", "HEAD", "POST", "PUT", "PATCH", "DELETE", "OPTIONS", "TRACE"),
+					stringvalidator.AlsoRequires(path.MatchRoot("path")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path of the endpoint of the callee this dependency refers to. Requires `method`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("method")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the dependency.",
+				Optional:            true,
+			},
+			"metadata": schema.StringAttribute{
+				MarkdownDescription: "Custom metadata for the dependency, in JSON format in a string. (Use the `jsonencode` function to convert a JSON object to a string.)",
+				Optional:            true,
+			},
+
+			// Computed attributes
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+/***********************************************************************************************************************
+ * Methods
+ **********************************************************************************************************************/
+
+func (r *CatalogEntityDependencyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_catalog_entity_dependency"
+}
+
+func (r *CatalogEntityDependencyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cortex.HttpClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CatalogEntityDependencyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	data := NewCatalogEntityDependencyEdgeResourceModel()
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Issue API request
+	entity, err := r.client.CatalogEntityDependencies().Get(ctx, data.CallerTag.ValueString(), data.CalleeTag.ValueString(), data.ToParamsApiModel())
+	if err != nil {
+		if errors.Is(err, cortex.ApiErrorNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read catalog entity dependency %s, got error: %s", data.Id.ValueString(), err))
+		return
+	}
+
+	// Map data from the API response to the model
+	data.FromApiModel(&resp.Diagnostics, entity)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CatalogEntityDependencyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	data := NewCatalogEntityDependencyEdgeResourceModel()
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	upsertRequest := data.ToUpsertApiModel(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entity, err := r.client.CatalogEntityDependencies().Create(ctx, data.CallerTag.ValueString(), data.CalleeTag.ValueString(), data.ToParamsApiModel(), upsertRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create catalog entity dependency, got error: %s", err))
+		return
+	}
+
+	// Set computed attributes
+	data.FromApiModel(&resp.Diagnostics, entity)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CatalogEntityDependencyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	data := NewCatalogEntityDependencyEdgeResourceModel()
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	upsertRequest := data.ToUpsertApiModel(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entity, err := r.client.CatalogEntityDependencies().Update(ctx, data.CallerTag.ValueString(), data.CalleeTag.ValueString(), data.ToParamsApiModel(), upsertRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update catalog entity dependency, got error: %s", err))
+		return
+	}
+
+	// Set computed attributes
+	data.FromApiModel(&resp.Diagnostics, entity)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CatalogEntityDependencyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	data := NewCatalogEntityDependencyEdgeResourceModel()
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.CatalogEntityDependencies().Delete(ctx, data.CallerTag.ValueString(), data.CalleeTag.ValueString(), data.ToParamsApiModel())
+	if err != nil && !errors.Is(err, cortex.ApiErrorNotFound) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete catalog entity dependency, got error: %s", err))
+		return
+	}
+}
+
+// ImportState accepts caller_tag:callee_tag, or caller_tag:callee_tag:method:path for endpoint dependencies.
+func (r *CatalogEntityDependencyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.SplitN(req.ID, ":", 4)
+
+	valid := (len(idParts) == 2 || len(idParts) == 4) && idParts[0] != "" && idParts[1] != ""
+	if len(idParts) == 4 && (idParts[2] == "" || idParts[3] == "") {
+		valid = false
+	}
+	if !valid {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: caller_tag:callee_tag or caller_tag:callee_tag:method:path. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("caller_tag"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("callee_tag"), idParts[1])...)
+	if len(idParts) == 4 {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("method"), idParts[2])...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("path"), idParts[3])...)
+	}
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+/***********************************************************************************************************************
+ * Models
+ **********************************************************************************************************************/
+
+// CatalogEntityDependencyEdgeResourceModel describes the standalone catalog entity dependency data model within Terraform.
+type CatalogEntityDependencyEdgeResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	CallerTag   types.String `tfsdk:"caller_tag"`
+	CalleeTag   types.String `tfsdk:"callee_tag"`
+	Method      types.String `tfsdk:"method"`
+	Path        types.String `tfsdk:"path"`
+	Description types.String `tfsdk:"description"`
+	Metadata    types.String `tfsdk:"metadata"`
+}
+
+func (o *CatalogEntityDependencyEdgeResourceModel) ToParamsApiModel() cortex.CatalogEntityDependencyParams {
+	return cortex.CatalogEntityDependencyParams{
+		Method: o.Method.ValueString(),
+		Path:   o.Path.ValueString(),
+	}
+}
+
+func (o *CatalogEntityDependencyEdgeResourceModel) ToUpsertApiModel(diagnostics *diag.Diagnostics) cortex.UpsertCatalogEntityDependencyRequest {
+	var metadata map[string]interface{}
+	if !o.Metadata.IsNull() && !o.Metadata.IsUnknown() && o.Metadata.ValueString() != "" {
+		err := json.Unmarshal([]byte(o.Metadata.ValueString()), &metadata)
+		if err != nil {
+			diagnostics.AddError("error parsing dependency metadata", fmt.Sprintf("%+v", err))
+			metadata = nil
+		}
+	}
+
+	return cortex.UpsertCatalogEntityDependencyRequest{
+		Description: o.Description.ValueString(),
+		Metadata:    metadata,
+	}
+}
+
+func (o *CatalogEntityDependencyEdgeResourceModel) FromApiModel(diagnostics *diag.Diagnostics, entity cortex.CatalogEntityDependencyEdge) {
+	o.Id = types.StringValue(entity.ID())
+	o.CallerTag = types.StringValue(entity.CallerTag)
+	o.CalleeTag = types.StringValue(entity.CalleeTag)
+	if entity.Method != "" {
+		o.Method = types.StringValue(entity.Method)
+	} else {
+		o.Method = types.StringNull()
+	}
+	if entity.Path != "" {
+		o.Path = types.StringValue(entity.Path)
+	} else {
+		o.Path = types.StringNull()
+	}
+	if entity.Description != "" {
+		o.Description = types.StringValue(entity.Description)
+	} else {
+		o.Description = types.StringNull()
+	}
+	if len(entity.Metadata) > 0 {
+		metadata, err := json.Marshal(entity.Metadata)
+		if err != nil {
+			diagnostics.AddError("error marshalling dependency metadata", fmt.Sprintf("%+v", err))
+			return
+		}
+		o.Metadata = types.StringValue(string(metadata))
+	} else {
+		o.Metadata = types.StringNull()
+	}
+}
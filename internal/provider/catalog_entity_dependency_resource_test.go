@@ -0,0 +1,79 @@
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+type testCatalogEntityDependencyResource struct {
+	CallerTag   string
+	CalleeTag   string
+	Method      string
+	Path        string
+	Description string
+}
+
+func (t *testCatalogEntityDependencyResource) ResourceFullName() string {
+	return t.ResourceType() + ".test"
+}
+
+func (t *testCatalogEntityDependencyResource) ResourceType() string {
+	return "cortex_catalog_entity_dependency"
+}
+
+func (t *testCatalogEntityDependencyResource) ToTerraform() string {
+	return fmt.Sprintf(`
+resource %[1]q "test" {
+  caller_tag  = %[2]q
+  callee_tag  = %[3]q
+  method      = %[4]q
+  path        = %[5]q
+  description = %[6]q
+  metadata    = jsonencode({ "tier" : "critical" })
+}`, t.ResourceType(), t.CallerTag, t.CalleeTag, t.Method, t.Path, t.Description)
+}
+
+func TestAccCatalogEntityDependencyResource(t *testing.T) {
+	stub := testCatalogEntityDependencyResource{
+		CallerTag:   "manual-test",
+		CalleeTag:   "manual-test-service",
+		Method:      "GET",
+		Path:        "/users/{id}",
+		Description: "Created by the Terraform acceptance tests",
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: stub.ToTerraform(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "id", "manual-test:manual-test-service:GET:/users/{id}"),
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "description", stub.Description),
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "metadata", `{"tier":"critical"}`),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      stub.ResourceFullName(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: func() string {
+					updated := stub
+					updated.Description = "Updated by the Terraform acceptance tests"
+					return updated.ToTerraform()
+				}(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "description", "Updated by the Terraform acceptance tests"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
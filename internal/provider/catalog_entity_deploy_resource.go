@@ -0,0 +1,270 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CatalogEntityDeployResource{}
+var _ resource.ResourceWithImportState = &CatalogEntityDeployResource{}
+
+func NewCatalogEntityDeployResource() resource.Resource {
+	return &CatalogEntityDeployResource{}
+}
+
+/***********************************************************************************************************************
+ * Types
+ **********************************************************************************************************************/
+
+// CatalogEntityDeployResource defines the resource implementation.
+type CatalogEntityDeployResource struct {
+	client *cortex.HttpClient
+}
+
+/***********************************************************************************************************************
+ * Schema
+ **********************************************************************************************************************/
+
+func (r *CatalogEntityDeployResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// Deploys are immutable records, so every attribute describing the deploy records a new one when changed.
+	requiresReplace := []planmodifier.String{
+		stringplanmodifier.RequiresReplace(),
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Catalog Entity Deploy. Records a deploy against a catalog entity. Changing any attribute other than `keep_on_destroy` records a new deploy.",
+
+		Attributes: map[string]schema.Attribute{
+			// Required attributes
+			"entity_tag": schema.StringAttribute{
+				MarkdownDescription: "Tag of the catalog entity that was deployed.",
+				Required:            true,
+				PlanModifiers:       requiresReplace,
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "Title of the deploy.",
+				Required:            true,
+				PlanModifiers:       requiresReplace,
+			},
+
+			// Optional attributes
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the deploy: `DEPLOY`, `SCALE`, `ROLLBACK` or `RESTART`. Defaults to `DEPLOY`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(cortex.DeployTypeDeploy),
+				Validators: []validator.String{
+					stringvalidator.OneOf(cortex.DeployTypeDeploy, cortex.DeployTypeScale, cortex.DeployTypeRollback, cortex.DeployTypeRestart),
+				},
+				PlanModifiers: requiresReplace,
+			},
+			"sha": schema.StringAttribute{
+				MarkdownDescription: "Commit SHA that was deployed.",
+				Optional:            true,
+				PlanModifiers:       requiresReplace,
+			},
+			"environment": schema.StringAttribute{
+				MarkdownDescription: "Environment the entity was deployed to.",
+				Optional:            true,
+				PlanModifiers:       requiresReplace,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "URL of the deploy, such as a link to the pipeline run.",
+				Optional:            true,
+				PlanModifiers:       requiresReplace,
+			},
+			"timestamp": schema.StringAttribute{
+				MarkdownDescription: "When the deploy happened, in RFC 3339 format. Defaults to when the deploy is recorded.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"deployer_name": schema.StringAttribute{
+				MarkdownDescription: "Name of who or what performed the deploy.",
+				Optional:            true,
+				PlanModifiers:       requiresReplace,
+			},
+			"deployer_email": schema.StringAttribute{
+				MarkdownDescription: "Email of who performed the deploy.",
+				Optional:            true,
+				PlanModifiers:       requiresReplace,
+			},
+			"custom_data": schema.StringAttribute{
+				MarkdownDescription: "Custom data for the deploy, in JSON format in a string. (Use the `jsonencode` function to convert a JSON object to a string.)",
+				Optional:            true,
+				PlanModifiers:       requiresReplace,
+			},
+			"keep_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Whether to keep the deploy record in Cortex when the resource is destroyed or replaced, so that deploy history is preserved when recording a new deploy. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+
+			// Computed attributes
+			"id": schema.StringAttribute{
+				MarkdownDescription: "UUID of the deploy.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+/***********************************************************************************************************************
+ * Methods
+ **********************************************************************************************************************/
+
+func (r *CatalogEntityDeployResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_catalog_entity_deploy"
+}
+
+func (r *CatalogEntityDeployResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cortex.HttpClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CatalogEntityDeployResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	data := NewCatalogEntityDeployResourceModel()
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Issue API request
+	entity, err := r.client.Deploys().Get(ctx, data.EntityTag.ValueString(), data.Id.ValueString())
+	if err != nil {
+		if errors.Is(err, cortex.ApiErrorNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read deploy %s, got error: %s", data.Id.ValueString(), err))
+		return
+	}
+
+	// Imported deploys have no configured keep_on_destroy.
+	if data.KeepOnDestroy.IsNull() {
+		data.KeepOnDestroy = types.BoolValue(true)
+	}
+
+	// Map data from the API response to the model
+	data.FromApiModel(&resp.Diagnostics, data.EntityTag.ValueString(), entity)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CatalogEntityDeployResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	data := NewCatalogEntityDeployResourceModel()
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientEntity := data.ToApiModel(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entity, err := r.client.Deploys().Create(ctx, data.EntityTag.ValueString(), clientEntity.ToCreateRequest())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create deploy, got error: %s", err))
+		return
+	}
+
+	data.FromApiModel(&resp.Diagnostics, data.EntityTag.ValueString(), entity)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update Only changes keep_on_destroy, which is not sent to the API; every other attribute forces replacement.
+func (r *CatalogEntityDeployResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	data := NewCatalogEntityDeployResourceModel()
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CatalogEntityDeployResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	data := NewCatalogEntityDeployResourceModel()
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.KeepOnDestroy.ValueBool() {
+		return
+	}
+
+	err := r.client.Deploys().Delete(ctx, data.EntityTag.ValueString(), data.Id.ValueString())
+	if err != nil && !errors.Is(err, cortex.ApiErrorNotFound) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete deploy, got error: %s", err))
+		return
+	}
+}
+
+func (r *CatalogEntityDeployResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ":")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: entity_tag:uuid. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("entity_tag"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+}
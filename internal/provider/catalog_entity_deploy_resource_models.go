@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+/***********************************************************************************************************************
+ * Models
+ **********************************************************************************************************************/
+
+// CatalogEntityDeployResourceModel describes the catalog entity deploy data model within Terraform.
+type CatalogEntityDeployResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	EntityTag     types.String `tfsdk:"entity_tag"`
+	Title         types.String `tfsdk:"title"`
+	Type          types.String `tfsdk:"type"`
+	Sha           types.String `tfsdk:"sha"`
+	Environment   types.String `tfsdk:"environment"`
+	Url           types.String `tfsdk:"url"`
+	Timestamp     types.String `tfsdk:"timestamp"`
+	DeployerName  types.String `tfsdk:"deployer_name"`
+	DeployerEmail types.String `tfsdk:"deployer_email"`
+	CustomData    types.String `tfsdk:"custom_data"`
+	KeepOnDestroy types.Bool   `tfsdk:"keep_on_destroy"`
+}
+
+func NewCatalogEntityDeployResourceModel() CatalogEntityDeployResourceModel {
+	return CatalogEntityDeployResourceModel{}
+}
+
+func (o *CatalogEntityDeployResourceModel) ToApiModel(diagnostics *diag.Diagnostics) cortex.Deploy {
+	entity := cortex.Deploy{
+		Title:       o.Title.ValueString(),
+		Type:        o.Type.ValueString(),
+		Sha:         o.Sha.ValueString(),
+		Environment: o.Environment.ValueString(),
+		Url:         o.Url.ValueString(),
+		Timestamp:   o.Timestamp.ValueString(),
+	}
+	if o.DeployerName.ValueString() != "" || o.DeployerEmail.ValueString() != "" {
+		entity.Deployer = &cortex.Deployer{
+			Name:  o.DeployerName.ValueString(),
+			Email: o.DeployerEmail.ValueString(),
+		}
+	}
+	if !o.CustomData.IsNull() && !o.CustomData.IsUnknown() && o.CustomData.ValueString() != "" {
+		err := json.Unmarshal([]byte(o.CustomData.ValueString()), &entity.CustomData)
+		if err != nil {
+			diagnostics.AddError("error parsing deploy custom data", fmt.Sprintf("%+v", err))
+		}
+	}
+	return entity
+}
+
+// FromApiModel maps the API deploy onto the model. KeepOnDestroy only affects how the resource is destroyed, so it is
+// left as configured.
+func (o *CatalogEntityDeployResourceModel) FromApiModel(diagnostics *diag.Diagnostics, entityTag string, entity cortex.Deploy) {
+	o.Id = types.StringValue(entity.Uuid)
+	o.EntityTag = types.StringValue(entityTag)
+	o.Title = types.StringValue(entity.Title)
+	o.Type = types.StringValue(entity.Type)
+	o.Sha = stringValueOrNull(entity.Sha)
+	o.Environment = stringValueOrNull(entity.Environment)
+	o.Url = stringValueOrNull(entity.Url)
+	o.Timestamp = timestampValue(o.Timestamp, entity.Timestamp)
+	if entity.Deployer != nil {
+		o.DeployerName = stringValueOrNull(entity.Deployer.Name)
+		o.DeployerEmail = stringValueOrNull(entity.Deployer.Email)
+	} else {
+		o.DeployerName = types.StringNull()
+		o.DeployerEmail = types.StringNull()
+	}
+	o.CustomData = customDataValue(diagnostics, entity.CustomData)
+}
+
+// CatalogEntityDeployDataSourceModel is a single deploy returned by the cortex_catalog_entity_deploys data source.
+type CatalogEntityDeployDataSourceModel struct {
+	Uuid          types.String `tfsdk:"uuid"`
+	Title         types.String `tfsdk:"title"`
+	Type          types.String `tfsdk:"type"`
+	Sha           types.String `tfsdk:"sha"`
+	Environment   types.String `tfsdk:"environment"`
+	Url           types.String `tfsdk:"url"`
+	Timestamp     types.String `tfsdk:"timestamp"`
+	DeployerName  types.String `tfsdk:"deployer_name"`
+	DeployerEmail types.String `tfsdk:"deployer_email"`
+	CustomData    types.String `tfsdk:"custom_data"`
+}
+
+func (o *CatalogEntityDeployDataSourceModel) FromApiModel(diagnostics *diag.Diagnostics, entity cortex.Deploy) {
+	o.Uuid = types.StringValue(entity.Uuid)
+	o.Title = types.StringValue(entity.Title)
+	o.Type = types.StringValue(entity.Type)
+	o.Sha = stringValueOrNull(entity.Sha)
+	o.Environment = stringValueOrNull(entity.Environment)
+	o.Url = stringValueOrNull(entity.Url)
+	o.Timestamp = stringValueOrNull(entity.Timestamp)
+	if entity.Deployer != nil {
+		o.DeployerName = stringValueOrNull(entity.Deployer.Name)
+		o.DeployerEmail = stringValueOrNull(entity.Deployer.Email)
+	} else {
+		o.DeployerName = types.StringNull()
+		o.DeployerEmail = types.StringNull()
+	}
+	o.CustomData = customDataValue(diagnostics, entity.CustomData)
+}
+
+/***********************************************************************************************************************
+ * Helpers
+ **********************************************************************************************************************/
+
+func stringValueOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// timestampValue keeps the configured timestamp if the API returns the same instant in a different format.
+func timestampValue(configured types.String, value string) types.String {
+	if configured.IsNull() || configured.IsUnknown() || value == "" {
+		return stringValueOrNull(value)
+	}
+	configuredTime, err := time.Parse(time.RFC3339, configured.ValueString())
+	if err != nil {
+		return types.StringValue(value)
+	}
+	valueTime, err := time.Parse(time.RFC3339, value)
+	if err != nil || !configuredTime.Equal(valueTime) {
+		return types.StringValue(value)
+	}
+	return configured
+}
+
+func customDataValue(diagnostics *diag.Diagnostics, customData map[string]interface{}) types.String {
+	if len(customData) == 0 {
+		return types.StringNull()
+	}
+	value, err := json.Marshal(customData)
+	if err != nil {
+		diagnostics.AddError("error marshalling custom data", fmt.Sprintf("%+v", err))
+		return types.StringNull()
+	}
+	return types.StringValue(string(value))
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCatalogEntityDeployResourceModelFromApiModel(t *testing.T) {
+	entity := cortex.Deploy{
+		Uuid:        "0d3c2a1e-5d8b-4f0e-9d1a-0a4d1e6b7c3f",
+		Title:       "Deploy abc123",
+		Timestamp:   "2024-05-01T12:00:00.000Z",
+		Type:        cortex.DeployTypeDeploy,
+		Sha:         "abc123",
+		Environment: "production",
+		Deployer:    &cortex.Deployer{Name: "Terraform"},
+		CustomData:  map[string]interface{}{"pipeline": "infra", "attempt": 2},
+	}
+
+	tests := []struct {
+		name              string
+		timestamp         types.String
+		expectedTimestamp string
+	}{
+		{name: "computed timestamp", timestamp: types.StringUnknown(), expectedTimestamp: "2024-05-01T12:00:00.000Z"},
+		{name: "same instant keeps configured format", timestamp: types.StringValue("2024-05-01T14:00:00+02:00"), expectedTimestamp: "2024-05-01T14:00:00+02:00"},
+		{name: "different instant", timestamp: types.StringValue("2024-05-02T12:00:00Z"), expectedTimestamp: "2024-05-01T12:00:00.000Z"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diagnostics := diag.Diagnostics{}
+			model := NewCatalogEntityDeployResourceModel()
+			model.Timestamp = tt.timestamp
+
+			model.FromApiModel(&diagnostics, "products-service", entity)
+			assert.False(t, diagnostics.HasError())
+			assert.Equal(t, entity.Uuid, model.Id.ValueString())
+			assert.Equal(t, "products-service", model.EntityTag.ValueString())
+			assert.Equal(t, tt.expectedTimestamp, model.Timestamp.ValueString())
+			assert.Equal(t, "Terraform", model.DeployerName.ValueString())
+			assert.True(t, model.DeployerEmail.IsNull())
+			assert.True(t, model.Url.IsNull())
+			assert.Equal(t, `{"attempt":2,"pipeline":"infra"}`, model.CustomData.ValueString())
+
+			deploy := model.ToApiModel(&diagnostics)
+			request := deploy.ToCreateRequest()
+			assert.False(t, diagnostics.HasError())
+			assert.Equal(t, &cortex.Deployer{Name: "Terraform"}, request.Deployer)
+			assert.Equal(t, map[string]interface{}{"pipeline": "infra", "attempt": float64(2)}, request.CustomData)
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+type testCatalogEntityDeployResource struct {
+	EntityTag   string
+	Sha         string
+	Environment string
+}
+
+func (t *testCatalogEntityDeployResource) ResourceFullName() string {
+	return t.ResourceType() + ".test"
+}
+
+func (t *testCatalogEntityDeployResource) ResourceType() string {
+	return "cortex_catalog_entity_deploy"
+}
+
+func (t *testCatalogEntityDeployResource) ToTerraform() string {
+	return fmt.Sprintf(`
+resource %[1]q "test" {
+  entity_tag      = %[2]q
+  title           = "Deploy %[3]s"
+  sha             = %[3]q
+  environment     = %[4]q
+  deployer_name   = "Terraform acceptance tests"
+  custom_data     = jsonencode({ "pipeline" : "acceptance" })
+  keep_on_destroy = false
+}
+
+data "cortex_catalog_entity_deploys" "test" {
+  entity_tag  = %[1]s.test.entity_tag
+  environment = %[1]s.test.environment
+  limit       = 1
+}`, t.ResourceType(), t.EntityTag, t.Sha, t.Environment)
+}
+
+func TestAccCatalogEntityDeployResource(t *testing.T) {
+	stub := testCatalogEntityDeployResource{
+		EntityTag:   "manual-test",
+		Sha:         "0a1b2c3",
+		Environment: "terraform-acceptance",
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: stub.ToTerraform(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(stub.ResourceFullName(), "id"),
+					resource.TestCheckResourceAttrSet(stub.ResourceFullName(), "timestamp"),
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "type", "DEPLOY"),
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "custom_data", `{"pipeline":"acceptance"}`),
+					resource.TestCheckResourceAttr("data.cortex_catalog_entity_deploys.test", "deploys.#", "1"),
+					resource.TestCheckResourceAttrPair("data.cortex_catalog_entity_deploys.test", "deploys.0.uuid", stub.ResourceFullName(), "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: stub.ResourceFullName(),
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources[stub.ResourceFullName()]
+					return rs.Primary.Attributes["entity_tag"] + ":" + rs.Primary.ID, nil
+				},
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"keep_on_destroy"},
+			},
+			// Replace testing
+			{
+				Config: func() string {
+					updated := stub
+					updated.Sha = "4d5e6f7"
+					return updated.ToTerraform()
+				}(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "sha", "4d5e6f7"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
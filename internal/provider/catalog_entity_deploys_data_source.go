@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CatalogEntityDeploysDataSource{}
+
+func NewCatalogEntityDeploysDataSource() datasource.DataSource {
+	return &CatalogEntityDeploysDataSource{}
+}
+
+// CatalogEntityDeploysDataSource defines the data source implementation.
+type CatalogEntityDeploysDataSource struct {
+	client *cortex.HttpClient
+}
+
+// CatalogEntityDeploysDataSourceModel describes the data source data model.
+type CatalogEntityDeploysDataSourceModel struct {
+	Id          types.String                         `tfsdk:"id"`
+	EntityTag   types.String                         `tfsdk:"entity_tag"`
+	Environment types.String                         `tfsdk:"environment"`
+	Limit       types.Int64                          `tfsdk:"limit"`
+	Deploys     []CatalogEntityDeployDataSourceModel `tfsdk:"deploys"`
+}
+
+func (d *CatalogEntityDeploysDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_catalog_entity_deploys"
+}
+
+func (d *CatalogEntityDeploysDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Catalog Entity Deploys data source - returns the deploys recorded against a catalog entity, most recent first",
+
+		Attributes: map[string]schema.Attribute{
+			// Required
+			"entity_tag": schema.StringAttribute{
+				MarkdownDescription: "Tag of the catalog entity",
+				Required:            true,
+			},
+
+			// Optional
+			"environment": schema.StringAttribute{
+				MarkdownDescription: "Only return deploys to this environment",
+				Optional:            true,
+			},
+			"limit": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of deploys to return. If omitted, every deploy is returned.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+
+			// Computed
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Internal identifier for this data source",
+				Computed:            true,
+			},
+			"deploys": schema.ListNestedAttribute{
+				MarkdownDescription: "Deploys of the entity, most recent first",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"uuid": schema.StringAttribute{
+							MarkdownDescription: "UUID of the deploy",
+							Computed:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "Title of the deploy",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the deploy: `DEPLOY`, `SCALE`, `ROLLBACK` or `RESTART`",
+							Computed:            true,
+						},
+						"sha": schema.StringAttribute{
+							MarkdownDescription: "Commit SHA that was deployed",
+							Computed:            true,
+						},
+						"environment": schema.StringAttribute{
+							MarkdownDescription: "Environment the entity was deployed to",
+							Computed:            true,
+						},
+						"url": schema.StringAttribute{
+							MarkdownDescription: "URL of the deploy",
+							Computed:            true,
+						},
+						"timestamp": schema.StringAttribute{
+							MarkdownDescription: "When the deploy happened",
+							Computed:            true,
+						},
+						"deployer_name": schema.StringAttribute{
+							MarkdownDescription: "Name of who or what performed the deploy",
+							Computed:            true,
+						},
+						"deployer_email": schema.StringAttribute{
+							MarkdownDescription: "Email of who performed the deploy",
+							Computed:            true,
+						},
+						"custom_data": schema.StringAttribute{
+							MarkdownDescription: "Custom data of the deploy, in JSON format in a string",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CatalogEntityDeploysDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cortex.HttpClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CatalogEntityDeploysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CatalogEntityDeploysDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &cortex.DeployListParams{
+		PageSize: 250,
+		Page:     0,
+	}
+	limit := int(data.Limit.ValueInt64())
+
+	// Fetch pages of results until the limit is reached, dropping deploys to other environments
+	data.Deploys = []CatalogEntityDeployDataSourceModel{}
+	for {
+		deploysResponse, err := d.client.Deploys().List(ctx, data.EntityTag.ValueString(), params)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read deploys of %s, got error: %s", data.EntityTag.ValueString(), err))
+			return
+		}
+
+		for _, deploy := range deploysResponse.Deployments {
+			if !data.Environment.IsNull() && deploy.Environment != data.Environment.ValueString() {
+				continue
+			}
+			item := CatalogEntityDeployDataSourceModel{}
+			item.FromApiModel(&resp.Diagnostics, deploy)
+			data.Deploys = append(data.Deploys, item)
+			if limit > 0 && len(data.Deploys) >= limit {
+				break
+			}
+		}
+
+		if (limit > 0 && len(data.Deploys) >= limit) || deploysResponse.Page >= deploysResponse.TotalPages-1 || len(deploysResponse.Deployments) == 0 {
+			break
+		}
+		params.Page++
+	}
+
+	data.Id = types.StringValue(data.EntityTag.ValueString())
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,287 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CatalogEntityGroupMembershipResource{}
+var _ resource.ResourceWithImportState = &CatalogEntityGroupMembershipResource{}
+
+func NewCatalogEntityGroupMembershipResource() resource.Resource {
+	return &CatalogEntityGroupMembershipResource{}
+}
+
+/***********************************************************************************************************************
+ * Types
+ **********************************************************************************************************************/
+
+// CatalogEntityGroupMembershipResource defines the resource implementation.
+type CatalogEntityGroupMembershipResource struct {
+	client *cortex.HttpClient
+}
+
+// CatalogEntityGroupMembershipResourceModel describes the catalog entity group membership data model within Terraform.
+type CatalogEntityGroupMembershipResourceModel struct {
+	Id         types.String   `tfsdk:"id"`
+	Group      types.String   `tfsdk:"group"`
+	EntityTags []types.String `tfsdk:"entity_tags"`
+}
+
+func (o *CatalogEntityGroupMembershipResourceModel) entityTags() []string {
+	tags := make([]string, len(o.EntityTags))
+	for i, tag := range o.EntityTags {
+		tags[i] = tag.ValueString()
+	}
+	return tags
+}
+
+/***********************************************************************************************************************
+ * Schema
+ **********************************************************************************************************************/
+
+func (r *CatalogEntityGroupMembershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Catalog Entity Group Membership. Adds a group to a set of catalog entities. Entities keep any other groups they belong to; set `ignore_groups` on `cortex_catalog_entity` resources whose entities are also listed here.",
+
+		Attributes: map[string]schema.Attribute{
+			// Required attributes
+			"group": schema.StringAttribute{
+				MarkdownDescription: "Name of the group. **Note:** Changing this attribute will force replacement of the resource.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"entity_tags": schema.SetAttribute{
+				MarkdownDescription: "Tags of the catalog entities in the group.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+
+			// Computed attributes
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Name of the group.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+/***********************************************************************************************************************
+ * Methods
+ **********************************************************************************************************************/
+
+func (r *CatalogEntityGroupMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_catalog_entity_group_membership"
+}
+
+func (r *CatalogEntityGroupMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cortex.HttpClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// listMembers returns the tags of every entity in the group, fetching every page.
+func (r *CatalogEntityGroupMembershipResource) listMembers(ctx context.Context, group string) ([]types.String, error) {
+	entities, err := r.client.CatalogEntities().ListAll(ctx, &cortex.CatalogEntityListParams{
+		Groups: []string{group},
+	})
+	if err != nil {
+		return nil, err
+	}
+	tags := make([]types.String, len(entities))
+	for i, entity := range entities {
+		tags[i] = types.StringValue(entity.Tag)
+	}
+	return tags, nil
+}
+
+func (r *CatalogEntityGroupMembershipResource) addGroup(ctx context.Context, group string, entityTags []string) error {
+	for _, tag := range entityTags {
+		err := r.client.CatalogEntityGroups().Add(ctx, tag, []string{group})
+		if err != nil {
+			return fmt.Errorf("could not add group to %s: %w", tag, err)
+		}
+	}
+	return nil
+}
+
+func (r *CatalogEntityGroupMembershipResource) removeGroup(ctx context.Context, group string, entityTags []string) error {
+	for _, tag := range entityTags {
+		err := r.client.CatalogEntityGroups().Remove(ctx, tag, []string{group})
+		if err != nil && !errors.Is(err, cortex.ApiErrorNotFound) {
+			return fmt.Errorf("could not remove group from %s: %w", tag, err)
+		}
+	}
+	return nil
+}
+
+func (r *CatalogEntityGroupMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	data := CatalogEntityGroupMembershipResourceModel{}
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, err := r.listMembers(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list catalog entities in group %s, got error: %s", data.Id.ValueString(), err))
+		return
+	}
+
+	// Imported memberships don't know their entities yet, so take every entity in the group.
+	if data.EntityTags == nil {
+		if len(members) == 0 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		data.Group = data.Id
+		data.EntityTags = members
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	// Only track the configured entities, so that entities added to the group elsewhere are left alone.
+	inGroup := map[string]bool{}
+	for _, tag := range members {
+		inGroup[tag.ValueString()] = true
+	}
+	tracked := []types.String{}
+	for _, tag := range data.EntityTags {
+		if inGroup[tag.ValueString()] {
+			tracked = append(tracked, tag)
+		}
+	}
+	data.EntityTags = tracked
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CatalogEntityGroupMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	data := CatalogEntityGroupMembershipResourceModel{}
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.addGroup(ctx, data.Group.ValueString(), data.entityTags())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create catalog entity group membership, got error: %s", err))
+		return
+	}
+
+	data.Id = data.Group
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CatalogEntityGroupMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	data := CatalogEntityGroupMembershipResourceModel{}
+	state := CatalogEntityGroupMembershipResourceModel{}
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planned := map[string]bool{}
+	for _, tag := range data.entityTags() {
+		planned[tag] = true
+	}
+	current := map[string]bool{}
+	var removed []string
+	for _, tag := range state.entityTags() {
+		current[tag] = true
+		if !planned[tag] {
+			removed = append(removed, tag)
+		}
+	}
+	// Entities that dropped out of the group elsewhere were already removed from state by Read, so they are re-added
+	// here too.
+	var added []string
+	for _, tag := range data.entityTags() {
+		if !current[tag] {
+			added = append(added, tag)
+		}
+	}
+
+	err := r.addGroup(ctx, data.Group.ValueString(), added)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update catalog entity group membership, got error: %s", err))
+		return
+	}
+	err = r.removeGroup(ctx, data.Group.ValueString(), removed)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update catalog entity group membership, got error: %s", err))
+		return
+	}
+
+	data.Id = data.Group
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CatalogEntityGroupMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	data := CatalogEntityGroupMembershipResourceModel{}
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.removeGroup(ctx, data.Group.ValueString(), data.entityTags())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete catalog entity group membership, got error: %s", err))
+		return
+	}
+}
+
+func (r *CatalogEntityGroupMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
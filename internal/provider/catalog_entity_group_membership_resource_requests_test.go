@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+// newGroupMembershipServer serves a group containing service-1 and service-2, recording every request made.
+func newGroupMembershipServer(t *testing.T) (*httptest.Server, *[]string) {
+	var mu sync.Mutex
+	requests := &[]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		*requests = append(*requests, r.Method+" "+r.URL.Path)
+		mu.Unlock()
+		if r.Method == http.MethodGet && r.URL.Path == "/api/v1/catalog/" {
+			assert.Equal(t, "tier-1", r.URL.Query().Get("groups"))
+			_, _ = w.Write([]byte(`{"entities":[{"tag":"service-1"},{"tag":"service-2"}],"page":0,"totalPages":1,"total":2}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	return server, requests
+}
+
+func newGroupMembershipState(t *testing.T, ctx context.Context, data CatalogEntityGroupMembershipResourceModel) tfsdk.State {
+	schemaResp := resource.SchemaResponse{}
+	NewCatalogEntityGroupMembershipResource().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := state.Set(ctx, &data)
+	assert.False(t, diags.HasError())
+	return state
+}
+
+func TestCatalogEntityGroupMembershipResourceRead(t *testing.T) {
+	ctx := context.Background()
+	server, requests := newGroupMembershipServer(t)
+	defer server.Close()
+	client, err := cortex.NewClient(cortex.WithURL(server.URL), cortex.WithToken("test-token"))
+	assert.Nil(t, err)
+	r := &CatalogEntityGroupMembershipResource{client: client}
+
+	state := newGroupMembershipState(t, ctx, CatalogEntityGroupMembershipResourceModel{
+		Id:         types.StringValue("tier-1"),
+		Group:      types.StringValue("tier-1"),
+		EntityTags: []types.String{types.StringValue("service-1"), types.StringValue("service-3")},
+	})
+	resp := resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+	assert.False(t, resp.Diagnostics.HasError())
+
+	data := CatalogEntityGroupMembershipResourceModel{}
+	resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+	assert.Equal(t, []string{"service-1"}, data.entityTags())
+	assert.Equal(t, []string{"GET /api/v1/catalog/"}, *requests)
+}
+
+func TestCatalogEntityGroupMembershipResourceUpdate(t *testing.T) {
+	ctx := context.Background()
+	server, requests := newGroupMembershipServer(t)
+	defer server.Close()
+	client, err := cortex.NewClient(cortex.WithURL(server.URL), cortex.WithToken("test-token"))
+	assert.Nil(t, err)
+	r := &CatalogEntityGroupMembershipResource{client: client}
+
+	state := newGroupMembershipState(t, ctx, CatalogEntityGroupMembershipResourceModel{
+		Id:         types.StringValue("tier-1"),
+		Group:      types.StringValue("tier-1"),
+		EntityTags: []types.String{types.StringValue("service-1"), types.StringValue("service-2")},
+	})
+	plan := newGroupMembershipState(t, ctx, CatalogEntityGroupMembershipResourceModel{
+		Id:         types.StringValue("tier-1"),
+		Group:      types.StringValue("tier-1"),
+		EntityTags: []types.String{types.StringValue("service-1"), types.StringValue("service-3")},
+	})
+	resp := resource.UpdateResponse{State: state}
+	r.Update(ctx, resource.UpdateRequest{Plan: tfsdk.Plan(plan), State: state}, &resp)
+	assert.False(t, resp.Diagnostics.HasError())
+	assert.Equal(t, []string{
+		"PUT /api/v1/catalog/service-3/groups",
+		"DELETE /api/v1/catalog/service-2/groups",
+	}, *requests)
+}
@@ -0,0 +1,70 @@
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccCatalogEntityGroupMembershipResource(entityTags string) string {
+	return fmt.Sprintf(`
+resource "cortex_catalog_entity" "test-group-membership-1" {
+  tag           = "test-group-membership-1"
+  name          = "Group membership service 1"
+  ignore_groups = true
+}
+
+resource "cortex_catalog_entity" "test-group-membership-2" {
+  tag           = "test-group-membership-2"
+  name          = "Group membership service 2"
+  ignore_groups = true
+}
+
+resource "cortex_catalog_entity_group_membership" "test" {
+  group       = "terraform-acceptance"
+  entity_tags = %s
+}
+
+data "cortex_catalog_entity_groups" "test" {
+  depends_on = [cortex_catalog_entity_group_membership.test]
+}
+`, entityTags)
+}
+
+func TestAccCatalogEntityGroupMembershipResource(t *testing.T) {
+	resourceName := "cortex_catalog_entity_group_membership.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCatalogEntityGroupMembershipResource("[cortex_catalog_entity.test-group-membership-1.tag]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "terraform-acceptance"),
+					resource.TestCheckResourceAttr(resourceName, "entity_tags.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.cortex_catalog_entity_groups.test", "groups.*", map[string]string{
+						"name":         "terraform-acceptance",
+						"entity_count": "1",
+					}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccCatalogEntityGroupMembershipResource("[cortex_catalog_entity.test-group-membership-1.tag, cortex_catalog_entity.test-group-membership-2.tag]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "entity_tags.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "entity_tags.*", "test-group-membership-2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
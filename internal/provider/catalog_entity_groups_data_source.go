@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CatalogEntityGroupsDataSource{}
+
+func NewCatalogEntityGroupsDataSource() datasource.DataSource {
+	return &CatalogEntityGroupsDataSource{}
+}
+
+// CatalogEntityGroupsDataSource defines the data source implementation.
+type CatalogEntityGroupsDataSource struct {
+	client *cortex.HttpClient
+}
+
+// CatalogEntityGroupsDataSourceModel describes the data source data model.
+type CatalogEntityGroupsDataSourceModel struct {
+	Id              types.String                      `tfsdk:"id"`
+	Types           []types.String                    `tfsdk:"types"`
+	IncludeArchived types.Bool                        `tfsdk:"include_archived"`
+	Groups          []CatalogEntityGroupItemDataModel `tfsdk:"groups"`
+}
+
+// CatalogEntityGroupItemDataModel is a single group returned by the data source.
+type CatalogEntityGroupItemDataModel struct {
+	Name        types.String   `tfsdk:"name"`
+	EntityCount types.Int64    `tfsdk:"entity_count"`
+	EntityTags  []types.String `tfsdk:"entity_tags"`
+}
+
+func (d *CatalogEntityGroupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_catalog_entity_groups"
+}
+
+func (d *CatalogEntityGroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Catalog Entity Groups data source - returns every group used by catalog entities, with the entities in each group",
+
+		Attributes: map[string]schema.Attribute{
+			// Optional
+			"types": schema.ListAttribute{
+				MarkdownDescription: "Only count entities of these types",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"include_archived": schema.BoolAttribute{
+				MarkdownDescription: "Whether to count archived entities",
+				Optional:            true,
+			},
+
+			// Computed
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Internal identifier for this data source",
+				Computed:            true,
+			},
+			"groups": schema.ListNestedAttribute{
+				MarkdownDescription: "Groups, sorted by name",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the group",
+							Computed:            true,
+						},
+						"entity_count": schema.Int64Attribute{
+							MarkdownDescription: "Number of entities in the group",
+							Computed:            true,
+						},
+						"entity_tags": schema.ListAttribute{
+							MarkdownDescription: "Tags of the entities in the group",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CatalogEntityGroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cortex.HttpClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CatalogEntityGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CatalogEntityGroupsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &cortex.CatalogEntityListParams{
+		IncludeArchived: data.IncludeArchived.ValueBool(),
+	}
+	for _, t := range data.Types {
+		params.Types = append(params.Types, t.ValueString())
+	}
+
+	entities, err := d.client.CatalogEntities().ListAll(ctx, params)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read catalog entities, got error: %s", err))
+		return
+	}
+
+	// Collect the entities in each group
+	members := map[string][]string{}
+	for _, entity := range entities {
+		for _, group := range entity.Groups {
+			members[group] = append(members[group], entity.Tag)
+		}
+	}
+
+	names := make([]string, 0, len(members))
+	for name := range members {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	data.Groups = make([]CatalogEntityGroupItemDataModel, len(names))
+	for i, name := range names {
+		tags := make([]types.String, len(members[name]))
+		for j, tag := range members[name] {
+			tags[j] = types.StringValue(tag)
+		}
+		data.Groups[i] = CatalogEntityGroupItemDataModel{
+			Name:        types.StringValue(name),
+			EntityCount: types.Int64Value(int64(len(tags))),
+			EntityTags:  tags,
+		}
+	}
+	data.Id = types.StringValue("catalog_entity_groups")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
@@ -10,7 +11,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -24,6 +27,11 @@ func NewCatalogEntityResource() resource.Resource {
 	return &CatalogEntityResource{}
 }
 
+const (
+	CatalogEntityDeletionBehaviorDelete  = "delete"
+	CatalogEntityDeletionBehaviorArchive = "archive"
+)
+
 func NewCatalogEntityResourceModel() CatalogEntityResourceModel {
 	return CatalogEntityResourceModel{}
 }
@@ -37,9 +45,81 @@ type CatalogEntityResource struct {
 	client *cortex.HttpClient
 }
 
-func (r *CatalogEntityResource) toUpsertRequest(ctx context.Context, diagnostics *diag.Diagnostics, data *CatalogEntityResourceModel) cortex.UpsertCatalogEntityRequest {
+// setArchived archives or unarchives the entity so that it matches the archived attribute.
+func (r *CatalogEntityResource) setArchived(ctx context.Context, diagnostics *diag.Diagnostics, data *CatalogEntityResourceModel) {
+	entity, err := r.client.CatalogEntities().Get(ctx, data.Tag.ValueString())
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read catalog entity, got error: %s", err))
+		return
+	}
+	if entity.IsArchived == data.Archived.ValueBool() {
+		return
+	}
+
+	if data.Archived.ValueBool() {
+		err = r.client.CatalogEntities().Archive(ctx, data.Tag.ValueString())
+	} else {
+		err = r.client.CatalogEntities().Unarchive(ctx, data.Tag.ValueString())
+	}
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update archived status of catalog entity, got error: %s", err))
+	}
+}
+
+// mergeExternalGroups adds the entity's current groups to the configured ones, so that upserting the descriptor keeps
+// groups managed elsewhere, such as by cortex_catalog_entity_group_membership. Groups removed from the configuration
+// are dropped.
+func (r *CatalogEntityResource) mergeExternalGroups(ctx context.Context, diagnostics *diag.Diagnostics, tag string, groups []string, removed []string) []string {
+	current, err := r.client.CatalogEntityGroups().Get(ctx, tag)
+	if err != nil {
+		if !errors.Is(err, cortex.ApiErrorNotFound) {
+			diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read groups of catalog entity, got error: %s", err))
+		}
+		return groups
+	}
+
+	skip := map[string]bool{}
+	for _, group := range groups {
+		skip[group] = true
+	}
+	for _, group := range removed {
+		skip[group] = true
+	}
+	for _, group := range current {
+		if !skip[group] {
+			groups = append(groups, group)
+			skip[group] = true
+		}
+	}
+	return groups
+}
+
+// configuredGroups returns the configured groups that the entity actually belongs to, in configuration order. Used with
+// ignore_groups, so that groups added elsewhere are ignored but configured groups that went missing show as drift.
+func configuredGroups(configured []types.String, actual []types.String) []types.String {
+	if configured == nil {
+		return nil
+	}
+	present := map[string]bool{}
+	for _, group := range actual {
+		present[group.ValueString()] = true
+	}
+	groups := []types.String{}
+	for _, group := range configured {
+		if present[group.ValueString()] {
+			groups = append(groups, group)
+		}
+	}
+	return groups
+}
+
+func (r *CatalogEntityResource) toUpsertRequest(ctx context.Context, diagnostics *diag.Diagnostics, data *CatalogEntityResourceModel, removedGroups []string) cortex.UpsertCatalogEntityRequest {
+	info := data.ToApiModel(ctx, diagnostics)
+	if data.IgnoreGroups.ValueBool() {
+		info.Groups = r.mergeExternalGroups(ctx, diagnostics, info.Tag, info.Groups, removedGroups)
+	}
 	return cortex.UpsertCatalogEntityRequest{
-		Info: data.ToApiModel(ctx, diagnostics),
+		Info: info,
 	}
 }
 
@@ -186,6 +266,10 @@ func (r *CatalogEntityResource) Schema(ctx context.Context, req resource.SchemaR
 					},
 				},
 			},
+			"ignore_groups": schema.BoolAttribute{
+				MarkdownDescription: "Whether groups added to the entity outside of this resource, such as by `cortex_catalog_entity_group_membership`, are ignored. Defaults to `false`. If set to `true`, the provider keeps the entity's other groups when updating it and only tracks the groups set in `groups`.",
+				Optional:            true,
+			},
 			"ignore_metadata": schema.BoolAttribute{
 				MarkdownDescription: "Whether the entity's custom metadata is managed by Terraform. Defaults to `false`. If set to `true`, the provider will ignore any metadata on the Entity and not persist it to state.",
 				Optional:            true,
@@ -1149,6 +1233,23 @@ func (r *CatalogEntityResource) Schema(ctx context.Context, req resource.SchemaR
 				},
 			},
 
+			// Lifecycle
+			"archived": schema.BoolAttribute{
+				MarkdownDescription: "Whether the entity is archived. Archived entities are hidden from the catalog and scorecards but keep their history. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"deletion_behavior": schema.StringAttribute{
+				MarkdownDescription: "What happens to the entity in Cortex when the resource is destroyed: `delete` permanently deletes it, `archive` archives it so that its history and scorecard data are kept. Defaults to `delete`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(CatalogEntityDeletionBehaviorDelete),
+				Validators: []validator.String{
+					stringvalidator.OneOf(CatalogEntityDeletionBehaviorDelete, CatalogEntityDeletionBehaviorArchive),
+				},
+			},
+
 			//Computed
 			"id": schema.StringAttribute{
 				Computed: true,
@@ -1190,9 +1291,10 @@ func (r *CatalogEntityResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 	oldMetadata := data.Metadata
+	oldGroups := data.Groups
 
 	// Parse configuration into an upsert entity
-	upsertRequest := r.toUpsertRequest(ctx, &resp.Diagnostics, &data)
+	upsertRequest := r.toUpsertRequest(ctx, &resp.Diagnostics, &data, nil)
 	if resp.Diagnostics.HasError() {
 		// If we have an issue parsing the TF configuration, bail out early
 		return
@@ -1210,6 +1312,14 @@ func (r *CatalogEntityResource) Create(ctx context.Context, req resource.CreateR
 	if data.IgnoreMetadata.ValueBool() {
 		data.Metadata = oldMetadata
 	}
+	if data.IgnoreGroups.ValueBool() {
+		data.Groups = configuredGroups(oldGroups, data.Groups)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.setArchived(ctx, &resp.Diagnostics, &data)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -1228,6 +1338,7 @@ func (r *CatalogEntityResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 	oldMetadata := data.Metadata
+	oldGroups := data.Groups
 
 	// Issue API request
 	entity, err := r.client.CatalogEntities().GetFromDescriptor(ctx, data.Tag.ValueString())
@@ -1242,10 +1353,24 @@ func (r *CatalogEntityResource) Read(ctx context.Context, req resource.ReadReque
 	if data.IgnoreMetadata.ValueBool() {
 		data.Metadata = oldMetadata
 	}
+	if data.IgnoreGroups.ValueBool() {
+		data.Groups = configuredGroups(oldGroups, data.Groups)
+	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// The descriptor doesn't include whether the entity is archived
+	details, err := r.client.CatalogEntities().Get(ctx, data.Tag.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read catalog entity, got error: %s", err))
+		return
+	}
+	data.Archived = types.BoolValue(details.IsArchived)
+	if data.DeletionBehavior.IsNull() {
+		data.DeletionBehavior = types.StringValue(CatalogEntityDeletionBehaviorDelete)
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -1259,9 +1384,29 @@ func (r *CatalogEntityResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 	oldMetadata := data.Metadata
+	oldGroups := data.Groups
+
+	// Groups removed from the configuration must not be kept as external groups
+	var removedGroups []string
+	if data.IgnoreGroups.ValueBool() {
+		state := NewCatalogEntityResourceModel()
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		planned := map[string]bool{}
+		for _, group := range data.Groups {
+			planned[group.ValueString()] = true
+		}
+		for _, group := range state.Groups {
+			if !planned[group.ValueString()] {
+				removedGroups = append(removedGroups, group.ValueString())
+			}
+		}
+	}
 
 	// Parse configuration into API entity
-	upsertRequest := r.toUpsertRequest(ctx, &resp.Diagnostics, &data)
+	upsertRequest := r.toUpsertRequest(ctx, &resp.Diagnostics, &data, removedGroups)
 	if resp.Diagnostics.HasError() {
 		// If we have an issue parsing the TF configuration, bail out early
 		return
@@ -1279,6 +1424,14 @@ func (r *CatalogEntityResource) Update(ctx context.Context, req resource.UpdateR
 	if data.IgnoreMetadata.ValueBool() {
 		data.Metadata = oldMetadata
 	}
+	if data.IgnoreGroups.ValueBool() {
+		data.Groups = configuredGroups(oldGroups, data.Groups)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.setArchived(ctx, &resp.Diagnostics, &data)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -1301,6 +1454,17 @@ func (r *CatalogEntityResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
+	if data.DeletionBehavior.ValueString() == CatalogEntityDeletionBehaviorArchive {
+		if data.Archived.ValueBool() {
+			return
+		}
+		err := r.client.CatalogEntities().Archive(ctx, data.Tag.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to archive catalog entity, got error: %s", err))
+		}
+		return
+	}
+
 	err := r.client.CatalogEntities().Delete(ctx, data.Tag.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete catalog entity, got error: %s", err))
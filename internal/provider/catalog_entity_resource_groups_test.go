@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCatalogEntityResourceMergeExternalGroups(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/catalog/test-service/groups", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"groups":[{"tag":"tier-1"},{"tag":"payments"},{"tag":"legacy"}]}`))
+	})
+	mux.HandleFunc("/api/v1/catalog/new-service/groups", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"type":"NOT_FOUND","message":"entity not found"}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client, err := cortex.NewClient(cortex.WithURL(server.URL), cortex.WithToken("test-token"))
+	assert.Nil(t, err)
+	r := &CatalogEntityResource{client: client}
+
+	tests := []struct {
+		name     string
+		tag      string
+		groups   []string
+		removed  []string
+		expected []string
+	}{
+		{name: "keeps external groups", tag: "test-service", groups: []string{"payments"}, expected: []string{"payments", "tier-1", "legacy"}},
+		{name: "drops removed groups", tag: "test-service", groups: []string{"payments"}, removed: []string{"legacy"}, expected: []string{"payments", "tier-1"}},
+		{name: "new entity", tag: "new-service", groups: []string{"payments"}, expected: []string{"payments"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diagnostics := diag.Diagnostics{}
+			groups := r.mergeExternalGroups(context.Background(), &diagnostics, tt.tag, tt.groups, tt.removed)
+			assert.False(t, diagnostics.HasError())
+			assert.Equal(t, tt.expected, groups)
+		})
+	}
+}
+
+func TestCatalogEntityResourceConfiguredGroups(t *testing.T) {
+	tests := []struct {
+		name       string
+		configured []types.String
+		actual     []types.String
+		expected   []types.String
+	}{
+		{name: "ignores external groups", configured: []types.String{types.StringValue("payments")}, actual: []types.String{types.StringValue("tier-1"), types.StringValue("payments")}, expected: []types.String{types.StringValue("payments")}},
+		{name: "missing configured group is drift", configured: []types.String{types.StringValue("payments"), types.StringValue("legacy")}, actual: []types.String{types.StringValue("tier-1"), types.StringValue("payments")}, expected: []types.String{types.StringValue("payments")}},
+		{name: "no configured groups", configured: nil, actual: []types.String{types.StringValue("tier-1")}, expected: nil},
+		{name: "every configured group missing", configured: []types.String{types.StringValue("payments")}, actual: nil, expected: []types.String{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, configuredGroups(tt.configured, tt.actual))
+		})
+	}
+}
@@ -723,3 +723,46 @@ resource "cortex_catalog_entity" "test-type-requires-replace" {
  type = %[4]q
 }`, tag, name, description, entityType)
 }
+
+func TestAccCatalogEntityResourceArchived(t *testing.T) {
+	resourceName := "cortex_catalog_entity.test-archived"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCatalogEntityResourceArchived(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "archived", "false"),
+					resource.TestCheckResourceAttr(resourceName, "deletion_behavior", "delete"),
+				),
+			},
+			// Archive testing
+			{
+				Config: testAccCatalogEntityResourceArchived(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "archived", "true"),
+				),
+			},
+			// Unarchive testing
+			{
+				Config: testAccCatalogEntityResourceArchived(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "archived", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCatalogEntityResourceArchived(archived bool) string {
+	return fmt.Sprintf(`
+resource "cortex_catalog_entity" "test-archived" {
+  tag      = "test-archived"
+  name     = "Archived service"
+  archived = %t
+}
+`, archived)
+}
@@ -0,0 +1,305 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CustomMetricResource{}
+var _ resource.ResourceWithImportState = &CustomMetricResource{}
+
+func NewCustomMetricResource() resource.Resource {
+	return &CustomMetricResource{}
+}
+
+/***********************************************************************************************************************
+ * Types
+ **********************************************************************************************************************/
+
+// CustomMetricResource defines the resource implementation.
+type CustomMetricResource struct {
+	client *cortex.HttpClient
+}
+
+/***********************************************************************************************************************
+ * Schema
+ **********************************************************************************************************************/
+
+func (r *CustomMetricResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Custom Metric. An Eng Intelligence metric calculated for each matching catalog entity, for use in scorecard rules and dashboards.",
+
+		Attributes: map[string]schema.Attribute{
+			// Required attributes
+			"tag": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier for the custom metric. Changing this forces a new custom metric to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the custom metric.",
+				Required:            true,
+			},
+
+			// Optional attributes
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the custom metric.",
+				Optional:            true,
+			},
+			"unit": schema.StringAttribute{
+				MarkdownDescription: "Unit the metric's values are displayed in, such as `deploys` or `hours`.",
+				Optional:            true,
+			},
+			"query": schema.StringAttribute{
+				MarkdownDescription: "CQL expression that calculates the metric's value for an entity. Exactly one of `query` and `aggregation` must be set.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("query"), path.MatchRoot("aggregation")),
+				},
+			},
+			"aggregation": schema.SingleNestedAttribute{
+				MarkdownDescription: "Aggregation that calculates the metric's value for an entity. Exactly one of `query` and `aggregation` must be set.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"function": schema.StringAttribute{
+						MarkdownDescription: "Aggregation function: `SUM`, `AVERAGE`, `MIN`, `MAX`, `COUNT` or `MEDIAN`.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								cortex.CustomMetricFunctionSum,
+								cortex.CustomMetricFunctionAverage,
+								cortex.CustomMetricFunctionMin,
+								cortex.CustomMetricFunctionMax,
+								cortex.CustomMetricFunctionCount,
+								cortex.CustomMetricFunctionMedian,
+							),
+						},
+					},
+					"source": schema.StringAttribute{
+						MarkdownDescription: "Data that is aggregated: `DEPLOYS`, `CUSTOM_EVENTS` or `CUSTOM_DATA`.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								cortex.CustomMetricSourceDeploys,
+								cortex.CustomMetricSourceCustomEvents,
+								cortex.CustomMetricSourceCustomData,
+							),
+						},
+					},
+					"key": schema.StringAttribute{
+						MarkdownDescription: "Custom event type or custom data key that is aggregated. Not used for `DEPLOYS`.",
+						Optional:            true,
+					},
+					"lookback_days": schema.Int64Attribute{
+						MarkdownDescription: "Number of days of data that are aggregated.",
+						Required:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+				},
+			},
+			"filter": schema.SingleNestedAttribute{
+				MarkdownDescription: "Entities the metric is calculated for, in the same format as a scorecard's filter. If omitted, the metric is calculated for every entity.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"types": schema.SingleNestedAttribute{
+						MarkdownDescription: "Filter by entity types.",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"include": schema.SetAttribute{
+								MarkdownDescription: "Entity types the metric is calculated for. Cannot be used with exclude.",
+								ElementType:         types.StringType,
+								Optional:            true,
+								Validators: []validator.Set{
+									setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("exclude")),
+								},
+							},
+							"exclude": schema.SetAttribute{
+								MarkdownDescription: "Entity types the metric is not calculated for. Cannot be used with include.",
+								ElementType:         types.StringType,
+								Optional:            true,
+								Validators: []validator.Set{
+									setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("include")),
+								},
+							},
+						},
+					},
+					"groups": schema.SingleNestedAttribute{
+						MarkdownDescription: "Filter by entity groups.",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"include": schema.SetAttribute{
+								MarkdownDescription: "Entity groups the metric is calculated for.",
+								ElementType:         types.StringType,
+								Optional:            true,
+							},
+							"exclude": schema.SetAttribute{
+								MarkdownDescription: "Entity groups the metric is not calculated for.",
+								ElementType:         types.StringType,
+								Optional:            true,
+							},
+						},
+					},
+					"query": schema.StringAttribute{
+						MarkdownDescription: "A CQL query that is run against the filtered entities; the metric is only calculated for entities matching this query.",
+						Optional:            true,
+					},
+				},
+			},
+
+			// Computed attributes
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the custom metric, equal to its tag.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+/***********************************************************************************************************************
+ * Methods
+ **********************************************************************************************************************/
+
+func (r *CustomMetricResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_metric"
+}
+
+func (r *CustomMetricResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cortex.HttpClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CustomMetricResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	data := NewCustomMetricResourceModel()
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Issue API request
+	entity, err := r.client.CustomMetrics().Get(ctx, data.Tag.ValueString())
+	if err != nil {
+		if errors.Is(err, cortex.ApiErrorNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read custom metric %s, got error: %s", data.Tag.ValueString(), err))
+		return
+	}
+
+	// Map data from the API response to the model
+	data.FromApiModel(ctx, &resp.Diagnostics, entity)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomMetricResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	data := NewCustomMetricResourceModel()
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientEntity := data.ToApiModel(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	entity, err := r.client.CustomMetrics().Create(ctx, clientEntity.ToCreateRequest())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create custom metric, got error: %s", err))
+		return
+	}
+
+	data.FromApiModel(ctx, &resp.Diagnostics, entity)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomMetricResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	data := NewCustomMetricResourceModel()
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientEntity := data.ToApiModel(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	entity, err := r.client.CustomMetrics().Update(ctx, data.Tag.ValueString(), clientEntity.ToUpdateRequest())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update custom metric, got error: %s", err))
+		return
+	}
+
+	data.FromApiModel(ctx, &resp.Diagnostics, entity)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomMetricResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	data := NewCustomMetricResourceModel()
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.CustomMetrics().Delete(ctx, data.Tag.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete custom metric, got error: %s", err))
+		return
+	}
+}
+
+func (r *CustomMetricResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("tag"), req, resp)
+}
@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+/***********************************************************************************************************************
+ * Models
+ **********************************************************************************************************************/
+
+// CustomMetricResourceModel describes the custom metric data model within Terraform. The filter has the same shape as
+// a scorecard's filter.
+type CustomMetricResourceModel struct {
+	Id          types.String                          `tfsdk:"id"`
+	Tag         types.String                          `tfsdk:"tag"`
+	Name        types.String                          `tfsdk:"name"`
+	Description types.String                          `tfsdk:"description"`
+	Unit        types.String                          `tfsdk:"unit"`
+	Query       types.String                          `tfsdk:"query"`
+	Aggregation *CustomMetricAggregationResourceModel `tfsdk:"aggregation"`
+	Filter      types.Object                          `tfsdk:"filter"`
+}
+
+type CustomMetricAggregationResourceModel struct {
+	Function     types.String `tfsdk:"function"`
+	Source       types.String `tfsdk:"source"`
+	Key          types.String `tfsdk:"key"`
+	LookbackDays types.Int64  `tfsdk:"lookback_days"`
+}
+
+func NewCustomMetricResourceModel() CustomMetricResourceModel {
+	return CustomMetricResourceModel{}
+}
+
+func (o *CustomMetricResourceModel) ToApiModel(ctx context.Context, diagnostics *diag.Diagnostics) cortex.CustomMetric {
+	entity := cortex.CustomMetric{
+		Tag:         o.Tag.ValueString(),
+		Name:        o.Name.ValueString(),
+		Description: o.Description.ValueString(),
+		Unit:        o.Unit.ValueString(),
+		Query:       o.Query.ValueString(),
+	}
+	if o.Aggregation != nil {
+		entity.Aggregation = &cortex.CustomMetricAggregation{
+			Function:     o.Aggregation.Function.ValueString(),
+			Source:       o.Aggregation.Source.ValueString(),
+			Key:          o.Aggregation.Key.ValueString(),
+			LookbackDays: o.Aggregation.LookbackDays.ValueInt64(),
+		}
+	}
+	if !o.Filter.IsNull() && !o.Filter.IsUnknown() {
+		filter := ScorecardFilterResourceModel{}
+		diagnostics.Append(o.Filter.As(ctx, &filter, getDefaultObjectOptions())...)
+		entity.Filter = filter.ToApiModel(ctx, diagnostics)
+	}
+	return entity
+}
+
+func (o *CustomMetricResourceModel) FromApiModel(ctx context.Context, diagnostics *diag.Diagnostics, entity cortex.CustomMetric) {
+	o.Id = types.StringValue(entity.Tag)
+	o.Tag = types.StringValue(entity.Tag)
+	o.Name = types.StringValue(entity.Name)
+	o.Description = stringValueOrNull(entity.Description)
+	o.Unit = stringValueOrNull(entity.Unit)
+	o.Query = stringValueOrNull(entity.Query)
+
+	o.Aggregation = nil
+	if entity.Aggregation != nil {
+		o.Aggregation = &CustomMetricAggregationResourceModel{
+			Function:     types.StringValue(entity.Aggregation.Function),
+			Source:       types.StringValue(entity.Aggregation.Source),
+			Key:          stringValueOrNull(entity.Aggregation.Key),
+			LookbackDays: types.Int64Value(entity.Aggregation.LookbackDays),
+		}
+	}
+
+	filter := ScorecardFilterResourceModel{}
+	o.Filter = filter.FromApiModel(ctx, diagnostics, &entity.Filter)
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCustomMetricResourceModelAggregationRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	entity := cortex.CustomMetric{
+		Tag:  "weekly-deploys",
+		Name: "Weekly Deploys",
+		Unit: "deploys",
+		Aggregation: &cortex.CustomMetricAggregation{
+			Function:     cortex.CustomMetricFunctionCount,
+			Source:       cortex.CustomMetricSourceDeploys,
+			LookbackDays: 7,
+		},
+		Filter: cortex.ScorecardFilter{
+			Kind:  "GENERIC",
+			Types: &cortex.ScorecardFilterTypes{Include: []string{"service"}},
+		},
+	}
+
+	diagnostics := diag.Diagnostics{}
+	model := NewCustomMetricResourceModel()
+	model.FromApiModel(ctx, &diagnostics, entity)
+	assert.False(t, diagnostics.HasError())
+	assert.Equal(t, entity.Tag, model.Id.ValueString())
+	assert.True(t, model.Query.IsNull())
+	assert.True(t, model.Aggregation.Key.IsNull())
+	assert.False(t, model.Filter.IsNull())
+
+	roundTrip := model.ToApiModel(ctx, &diagnostics)
+	assert.False(t, diagnostics.HasError())
+	assert.Equal(t, entity.Tag, roundTrip.Tag)
+	assert.Equal(t, entity.Name, roundTrip.Name)
+	assert.Equal(t, entity.Unit, roundTrip.Unit)
+	assert.Equal(t, entity.Aggregation, roundTrip.Aggregation)
+	assert.Equal(t, []string{"service"}, roundTrip.Filter.Types.Include)
+}
+
+func TestCustomMetricResourceModelQueryWithoutFilter(t *testing.T) {
+	ctx := context.Background()
+	entity := cortex.CustomMetric{
+		Tag:   "open-incidents",
+		Name:  "Open Incidents",
+		Query: "oncall.incidents().length",
+	}
+
+	diagnostics := diag.Diagnostics{}
+	model := NewCustomMetricResourceModel()
+	model.FromApiModel(ctx, &diagnostics, entity)
+	assert.False(t, diagnostics.HasError())
+	assert.Nil(t, model.Aggregation)
+	assert.True(t, model.Filter.IsNull())
+	assert.True(t, model.Unit.IsNull())
+
+	assert.Equal(t, entity, model.ToApiModel(ctx, &diagnostics))
+}
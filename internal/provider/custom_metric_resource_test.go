@@ -0,0 +1,91 @@
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+type testCustomMetricResource struct {
+	Tag          string
+	Name         string
+	LookbackDays int64
+}
+
+func (t *testCustomMetricResource) ResourceFullName() string {
+	return t.ResourceType() + "." + t.Tag
+}
+
+func (t *testCustomMetricResource) ResourceType() string {
+	return "cortex_custom_metric"
+}
+
+func (t *testCustomMetricResource) ToTerraform() string {
+	return fmt.Sprintf(`
+resource %[1]q %[2]q {
+  tag  = %[2]q
+  name = %[3]q
+  unit = "deploys"
+
+  aggregation = {
+    function      = "COUNT"
+    source        = "DEPLOYS"
+    lookback_days = %[4]d
+  }
+
+  filter = {
+    types = {
+      include = ["service"]
+    }
+  }
+}
+
+data "cortex_custom_metric_values" %[2]q {
+  tag = %[1]s.%[2]s.tag
+}`, t.ResourceType(), t.Tag, t.Name, t.LookbackDays)
+}
+
+func TestAccCustomMetricResource(t *testing.T) {
+	stub := testCustomMetricResource{
+		Tag:          "test-custom-metric",
+		Name:         "Test Custom Metric",
+		LookbackDays: 7,
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: stub.ToTerraform(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "id", stub.Tag),
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "name", stub.Name),
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "aggregation.lookback_days", "7"),
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "filter.types.include.#", "1"),
+					resource.TestCheckResourceAttrSet("data.cortex_custom_metric_values."+stub.Tag, "values.#"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      stub.ResourceFullName(),
+				ImportState:       true,
+				ImportStateId:     stub.Tag,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: func() string {
+					updated := stub
+					updated.LookbackDays = 30
+					return updated.ToTerraform()
+				}(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "aggregation.lookback_days", "30"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
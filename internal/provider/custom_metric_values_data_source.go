@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CustomMetricValuesDataSource{}
+
+func NewCustomMetricValuesDataSource() datasource.DataSource {
+	return &CustomMetricValuesDataSource{}
+}
+
+// CustomMetricValuesDataSource defines the data source implementation.
+type CustomMetricValuesDataSource struct {
+	client *cortex.HttpClient
+}
+
+// CustomMetricValuesDataSourceModel describes the data source data model.
+type CustomMetricValuesDataSourceModel struct {
+	Id        types.String                           `tfsdk:"id"`
+	Tag       types.String                           `tfsdk:"tag"`
+	EntityTag types.String                           `tfsdk:"entity_tag"`
+	Values    []CustomMetricValueDataSourceItemModel `tfsdk:"values"`
+}
+
+// CustomMetricValueDataSourceItemModel is the current value of a custom metric for a single entity.
+type CustomMetricValueDataSourceItemModel struct {
+	EntityTag    types.String  `tfsdk:"entity_tag"`
+	EntityName   types.String  `tfsdk:"entity_name"`
+	Value        types.Float64 `tfsdk:"value"`
+	CalculatedAt types.String  `tfsdk:"calculated_at"`
+}
+
+func (d *CustomMetricValuesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_metric_values"
+}
+
+func (d *CustomMetricValuesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Custom Metric Values data source - returns the current value of a custom metric for each entity it is calculated for",
+
+		Attributes: map[string]schema.Attribute{
+			// Required
+			"tag": schema.StringAttribute{
+				MarkdownDescription: "Tag of the custom metric",
+				Required:            true,
+			},
+
+			// Optional
+			"entity_tag": schema.StringAttribute{
+				MarkdownDescription: "Only return the value for the entity with this tag",
+				Optional:            true,
+			},
+
+			// Computed
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Internal identifier for this data source",
+				Computed:            true,
+			},
+			"values": schema.ListNestedAttribute{
+				MarkdownDescription: "Current value of the metric for each entity",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"entity_tag": schema.StringAttribute{
+							MarkdownDescription: "Tag of the entity",
+							Computed:            true,
+						},
+						"entity_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the entity",
+							Computed:            true,
+						},
+						"value": schema.Float64Attribute{
+							MarkdownDescription: "Current value of the metric for the entity",
+							Computed:            true,
+						},
+						"calculated_at": schema.StringAttribute{
+							MarkdownDescription: "When the value was last calculated, in RFC 3339 format",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CustomMetricValuesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cortex.HttpClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CustomMetricValuesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CustomMetricValuesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	values, err := d.client.CustomMetrics().AllValues(ctx, data.Tag.ValueString(), data.EntityTag.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read values of custom metric %s, got error: %s", data.Tag.ValueString(), err))
+		return
+	}
+
+	data.Id = data.Tag
+	data.Values = make([]CustomMetricValueDataSourceItemModel, len(values))
+	for i, value := range values {
+		data.Values[i] = CustomMetricValueDataSourceItemModel{
+			EntityTag:    types.StringValue(value.EntityTag),
+			EntityName:   stringValueOrNull(value.EntityName),
+			Value:        types.Float64Value(value.Value),
+			CalculatedAt: stringValueOrNull(value.CalculatedAt),
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,252 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &EntityRelationshipResource{}
+var _ resource.ResourceWithImportState = &EntityRelationshipResource{}
+
+func NewEntityRelationshipResource() resource.Resource {
+	return &EntityRelationshipResource{}
+}
+
+/***********************************************************************************************************************
+ * Types
+ **********************************************************************************************************************/
+
+// EntityRelationshipResource defines the resource implementation.
+type EntityRelationshipResource struct {
+	client *cortex.HttpClient
+}
+
+// EntityRelationshipResourceModel describes the entity relationship data model within Terraform.
+type EntityRelationshipResourceModel struct {
+	Id               types.String `tfsdk:"id"`
+	RelationshipType types.String `tfsdk:"relationship_type"`
+	SourceTag        types.String `tfsdk:"source_tag"`
+	DestinationTag   types.String `tfsdk:"destination_tag"`
+	DestinationName  types.String `tfsdk:"destination_name"`
+}
+
+func (o *EntityRelationshipResourceModel) ToDestinationsApiModel() cortex.CatalogEntityRelationshipDestinationsRequest {
+	return cortex.CatalogEntityRelationshipDestinationsRequest{
+		Destinations: []cortex.CatalogEntityRelationshipEntity{{Tag: o.DestinationTag.ValueString()}},
+	}
+}
+
+func (o *EntityRelationshipResourceModel) FromApiModel(destination cortex.CatalogEntityRelationshipEntity) {
+	o.Id = types.StringValue(o.RelationshipType.ValueString() + ":" + o.SourceTag.ValueString() + ":" + destination.Tag)
+	o.DestinationTag = types.StringValue(destination.Tag)
+	if destination.Name != "" {
+		o.DestinationName = types.StringValue(destination.Name)
+	} else {
+		o.DestinationName = types.StringNull()
+	}
+}
+
+/***********************************************************************************************************************
+ * Schema
+ **********************************************************************************************************************/
+
+func (r *EntityRelationshipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Entity Relationship. A single relationship of a custom relationship type from a source entity to a destination entity.",
+
+		Attributes: map[string]schema.Attribute{
+			// Required attributes
+			"relationship_type": schema.StringAttribute{
+				MarkdownDescription: "Tag of the relationship type.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_tag": schema.StringAttribute{
+				MarkdownDescription: "Tag of the source entity.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"destination_tag": schema.StringAttribute{
+				MarkdownDescription: "Tag of the destination entity.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Computed attributes
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"destination_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the destination entity.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+/***********************************************************************************************************************
+ * Methods
+ **********************************************************************************************************************/
+
+func (r *EntityRelationshipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_entity_relationship"
+}
+
+func (r *EntityRelationshipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cortex.HttpClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// findDestination returns the relationship's destination, or ApiErrorNotFound if the source does not have it.
+func (r *EntityRelationshipResource) findDestination(ctx context.Context, data EntityRelationshipResourceModel) (cortex.CatalogEntityRelationshipEntity, error) {
+	destinations, err := r.client.CatalogEntityRelationships().ListDestinations(ctx, data.SourceTag.ValueString(), data.RelationshipType.ValueString())
+	if err != nil {
+		return cortex.CatalogEntityRelationshipEntity{}, err
+	}
+	for _, destination := range destinations {
+		if destination.Tag == data.DestinationTag.ValueString() {
+			return destination, nil
+		}
+	}
+	return cortex.CatalogEntityRelationshipEntity{}, cortex.ApiErrorNotFound
+}
+
+func (r *EntityRelationshipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	data := EntityRelationshipResourceModel{}
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Issue API request
+	destination, err := r.findDestination(ctx, data)
+	if err != nil {
+		if errors.Is(err, cortex.ApiErrorNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read entity relationship %s, got error: %s", data.Id.ValueString(), err))
+		return
+	}
+
+	data.FromApiModel(destination)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EntityRelationshipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	data := EntityRelationshipResourceModel{}
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.CatalogEntityRelationships().AddDestinations(ctx, data.SourceTag.ValueString(), data.RelationshipType.ValueString(), data.ToDestinationsApiModel())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create entity relationship, got error: %s", err))
+		return
+	}
+
+	destination, err := r.findDestination(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read entity relationship after creating it, got error: %s", err))
+		return
+	}
+
+	data.FromApiModel(destination)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is never called with changes, as every configurable attribute requires replacement.
+func (r *EntityRelationshipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	data := EntityRelationshipResourceModel{}
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EntityRelationshipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	data := EntityRelationshipResourceModel{}
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.CatalogEntityRelationships().RemoveDestinations(ctx, data.SourceTag.ValueString(), data.RelationshipType.ValueString(), data.ToDestinationsApiModel())
+	if err != nil && !errors.Is(err, cortex.ApiErrorNotFound) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete entity relationship, got error: %s", err))
+		return
+	}
+}
+
+func (r *EntityRelationshipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ":")
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: relationship_type:source_tag:destination_tag. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("relationship_type"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("source_tag"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("destination_tag"), idParts[2])...)
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEntityRelationshipResourceModelFromApiModel(t *testing.T) {
+	data := EntityRelationshipResourceModel{
+		RelationshipType: types.StringValue("runs-on"),
+		SourceTag:        types.StringValue("products-service"),
+	}
+
+	data.FromApiModel(cortex.CatalogEntityRelationshipEntity{Tag: "prod-cluster", Name: "Production Cluster"})
+	assert.Equal(t, "runs-on:products-service:prod-cluster", data.Id.ValueString())
+	assert.Equal(t, "prod-cluster", data.DestinationTag.ValueString())
+	assert.Equal(t, "Production Cluster", data.DestinationName.ValueString())
+
+	data.FromApiModel(cortex.CatalogEntityRelationshipEntity{Tag: "prod-cluster"})
+	assert.True(t, data.DestinationName.IsNull())
+	assert.Equal(t, cortex.CatalogEntityRelationshipDestinationsRequest{
+		Destinations: []cortex.CatalogEntityRelationshipEntity{{Tag: "prod-cluster"}},
+	}, data.ToDestinationsApiModel())
+}
+
+func TestEntityRelationshipResourceImportState(t *testing.T) {
+	ctx := context.Background()
+	r := &EntityRelationshipResource{}
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	tests := []struct {
+		name     string
+		id       string
+		expected *EntityRelationshipResourceModel
+	}{
+		{
+			name: "valid",
+			id:   "runs-on:products-service:prod-cluster",
+			expected: &EntityRelationshipResourceModel{
+				RelationshipType: types.StringValue("runs-on"),
+				SourceTag:        types.StringValue("products-service"),
+				DestinationTag:   types.StringValue("prod-cluster"),
+			},
+		},
+		{name: "missing destination", id: "runs-on:products-service"},
+		{name: "empty destination", id: "runs-on:products-service:"},
+		{name: "empty source", id: "runs-on::prod-cluster"},
+		{name: "too many parts", id: "runs-on:products-service:prod-cluster:extra"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := resource.ImportStateResponse{
+				State: tfsdk.State{
+					Schema: schemaResp.Schema,
+					Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+				},
+			}
+			r.ImportState(ctx, resource.ImportStateRequest{ID: tt.id}, &resp)
+			if tt.expected == nil {
+				assert.True(t, resp.Diagnostics.HasError())
+				assert.Equal(t, "Unexpected Import Identifier", resp.Diagnostics.Errors()[0].Summary())
+				return
+			}
+			assert.False(t, resp.Diagnostics.HasError())
+
+			data := EntityRelationshipResourceModel{}
+			resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+			assert.Equal(t, tt.expected.RelationshipType, data.RelationshipType)
+			assert.Equal(t, tt.expected.SourceTag, data.SourceTag)
+			assert.Equal(t, tt.expected.DestinationTag, data.DestinationTag)
+		})
+	}
+}
@@ -0,0 +1,296 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &EntityTypeResource{}
+var _ resource.ResourceWithImportState = &EntityTypeResource{}
+var _ resource.ResourceWithMoveState = &EntityTypeResource{}
+
+func NewEntityTypeResource() resource.Resource {
+	return &EntityTypeResource{}
+}
+
+/***********************************************************************************************************************
+ * Types
+ **********************************************************************************************************************/
+
+// EntityTypeResource defines the resource implementation. Custom entity types are stored as resource definitions, so
+// this uses the same API as ResourceDefinitionResource.
+type EntityTypeResource struct {
+	client *cortex.HttpClient
+}
+
+/***********************************************************************************************************************
+ * Schema
+ **********************************************************************************************************************/
+
+func (r *EntityTypeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_entity_type"
+}
+
+func (r *EntityTypeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Custom Entity Type. Existing `cortex_resource_definition` resources can be migrated to this resource with a `moved` block.",
+
+		Attributes: map[string]schema.Attribute{
+			// Required attributes
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier for the entity type. Changing this forces a new entity type to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the entity type.",
+				Required:            true,
+			},
+			"schema": schema.StringAttribute{
+				MarkdownDescription: "JSON schema that the metadata of entities of this type must match.",
+				Required:            true,
+			},
+
+			// Optional attributes
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the entity type.",
+				Optional:            true,
+			},
+			"display_name": schema.StringAttribute{
+				MarkdownDescription: "Name shown for entities of this type in the Cortex UI, for example in the catalog's navigation.",
+				Optional:            true,
+			},
+			"icon": schema.StringAttribute{
+				MarkdownDescription: "Tag of the icon shown for entities of this type.",
+				Optional:            true,
+			},
+			"parent_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the entities that entities of this type belong to in the hierarchy, for example `domain`.",
+				Optional:            true,
+			},
+			"can_own_entities": schema.BoolAttribute{
+				MarkdownDescription: "Whether entities of this type may be set as the owner of other entities. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+
+			// Computed attributes
+			"source": schema.StringAttribute{
+				MarkdownDescription: "Source of the entity type. Either \"CORTEX\" or \"CUSTOM\".",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+/***********************************************************************************************************************
+ * Methods
+ **********************************************************************************************************************/
+
+func (r *EntityTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cortex.HttpClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *EntityTypeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	data := NewEntityTypeResourceModel()
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Issue API request
+	entity, err := r.client.ResourceDefinitions().Get(ctx, data.Type.ValueString())
+	if err != nil {
+		if errors.Is(err, cortex.ApiErrorNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read entity type %s, got error: %s", data.Type.ValueString(), err))
+		return
+	}
+
+	// Map data from the API response to the model
+	data.FromApiModel(ctx, &resp.Diagnostics, entity)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EntityTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	data := NewEntityTypeResourceModel()
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientEntity := data.ToApiModel(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entity, err := r.client.ResourceDefinitions().CreateEntityType(ctx, cortex.CreateEntityTypeRequest{
+		Type:           clientEntity.Type,
+		Name:           clientEntity.Name,
+		Description:    clientEntity.Description,
+		Schema:         clientEntity.Schema,
+		DisplayName:    data.DisplayName.ValueStringPointer(),
+		IconTag:        data.Icon.ValueStringPointer(),
+		ParentType:     data.ParentType.ValueStringPointer(),
+		CanOwnEntities: clientEntity.CanOwnEntities,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create entity type, got error: %s", err))
+		return
+	}
+
+	// Set computed attributes
+	data.FromApiModel(ctx, &resp.Diagnostics, entity)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EntityTypeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	data := NewEntityTypeResourceModel()
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientEntity := data.ToApiModel(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entity, err := r.client.ResourceDefinitions().UpdateEntityType(ctx, data.Type.ValueString(), cortex.UpdateEntityTypeRequest{
+		Name:           clientEntity.Name,
+		Description:    clientEntity.Description,
+		Schema:         clientEntity.Schema,
+		DisplayName:    data.DisplayName.ValueStringPointer(),
+		IconTag:        data.Icon.ValueStringPointer(),
+		ParentType:     data.ParentType.ValueStringPointer(),
+		CanOwnEntities: clientEntity.CanOwnEntities,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update entity type, got error: %s", err))
+		return
+	}
+
+	// Set computed attributes
+	data.FromApiModel(ctx, &resp.Diagnostics, entity)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EntityTypeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	data := NewEntityTypeResourceModel()
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.ResourceDefinitions().Delete(ctx, data.Type.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete entity type, got error: %s", err))
+		return
+	}
+}
+
+func (r *EntityTypeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("type"), req, resp)
+}
+
+// MoveState supports `moved` blocks from cortex_resource_definition to cortex_entity_type. Both manage the same
+// object in Cortex, so only the Terraform state changes; the entity type's additional fields are filled in by the
+// refresh that follows the move.
+func (r *EntityTypeResource) MoveState(ctx context.Context) []resource.StateMover {
+	sourceSchema := resource.SchemaResponse{}
+	NewResourceDefinitionResource().Schema(ctx, resource.SchemaRequest{}, &sourceSchema)
+
+	return []resource.StateMover{
+		{
+			SourceSchema: &sourceSchema.Schema,
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceTypeName != "cortex_resource_definition" || !strings.HasSuffix(req.SourceProviderAddress, "cortexapps/cortex") {
+					return
+				}
+				if req.SourceState == nil {
+					resp.Diagnostics.AddError(
+						"Unable to Move Resource State",
+						"The cortex_resource_definition state could not be read. Please upgrade it to the latest version of the provider before moving it.",
+					)
+					return
+				}
+
+				source := NewResourceDefinitionResourceModel()
+				resp.Diagnostics.Append(req.SourceState.Get(ctx, &source)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				data := NewEntityTypeResourceModelFromResourceDefinition(source)
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, &data)...)
+			},
+		},
+	}
+}
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+/***********************************************************************************************************************
+ * Models
+ **********************************************************************************************************************/
+
+// EntityTypeResourceModel describes the custom entity type data model within Terraform. It is a superset of
+// ResourceDefinitionResourceModel, so that cortex_resource_definition state can be moved into it.
+type EntityTypeResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	Type           types.String `tfsdk:"type"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	Source         types.String `tfsdk:"source"`
+	Schema         types.String `tfsdk:"schema"`
+	DisplayName    types.String `tfsdk:"display_name"`
+	Icon           types.String `tfsdk:"icon"`
+	ParentType     types.String `tfsdk:"parent_type"`
+	CanOwnEntities types.Bool   `tfsdk:"can_own_entities"`
+}
+
+func NewEntityTypeResourceModel() EntityTypeResourceModel {
+	return EntityTypeResourceModel{}
+}
+
+// NewEntityTypeResourceModelFromResourceDefinition maps cortex_resource_definition state onto an entity type. The
+// fields that only entity types have are left unset until the next refresh.
+func NewEntityTypeResourceModelFromResourceDefinition(source ResourceDefinitionResourceModel) EntityTypeResourceModel {
+	return EntityTypeResourceModel{
+		Id:             source.Id,
+		Type:           source.Type,
+		Name:           source.Name,
+		Description:    source.Description,
+		Source:         source.Source,
+		Schema:         source.Schema,
+		DisplayName:    types.StringNull(),
+		Icon:           types.StringNull(),
+		ParentType:     types.StringNull(),
+		CanOwnEntities: types.BoolValue(false),
+	}
+}
+
+func (r *EntityTypeResourceModel) FromApiModel(ctx context.Context, diagnostics *diag.Diagnostics, entity cortex.ResourceDefinition) {
+	r.Id = types.StringValue(entity.Type)
+	r.Type = types.StringValue(entity.Type)
+	r.Name = types.StringValue(entity.Name)
+	r.Source = types.StringValue(entity.Source)
+	r.Description = stringValueOrNull(entity.Description)
+	r.DisplayName = stringValueOrNull(entity.DisplayName)
+	r.Icon = stringValueOrNull(entity.IconTag)
+	r.ParentType = stringValueOrNull(entity.ParentType)
+	r.CanOwnEntities = types.BoolValue(entity.CanOwnEntities)
+
+	schema := make(map[string]interface{})
+	if len(entity.Schema) > 0 {
+		schema = entity.Schema
+	}
+	sv, err := json.Marshal(schema)
+	if err != nil {
+		diagnostics.AddError("Error parsing schema: %s", err.Error())
+		return
+	}
+	r.Schema = types.StringValue(string(sv))
+}
+
+func (r *EntityTypeResourceModel) ToApiModel(diagnostics *diag.Diagnostics) cortex.ResourceDefinition {
+	entity := cortex.ResourceDefinition{
+		Type:           r.Type.ValueString(),
+		Name:           r.Name.ValueString(),
+		Description:    r.Description.ValueString(),
+		Source:         r.Source.ValueString(),
+		DisplayName:    r.DisplayName.ValueString(),
+		IconTag:        r.Icon.ValueString(),
+		ParentType:     r.ParentType.ValueString(),
+		CanOwnEntities: r.CanOwnEntities.ValueBool(),
+	}
+
+	schema := make(map[string]interface{})
+	if !r.Schema.IsNull() && !r.Schema.IsUnknown() && r.Schema.ValueString() != "" {
+		err := json.Unmarshal([]byte(r.Schema.ValueString()), &schema)
+		if err != nil {
+			diagnostics.AddError(
+				"Unable to Convert Entity Type Schema",
+				"An unexpected result occurred when deserializing the schema from JSON. Please ensure your entity type schema is valid JSON.",
+			)
+			schema = make(map[string]interface{})
+		}
+	}
+	entity.Schema = schema
+
+	return entity
+}
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEntityTypeResourceModelRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	diagnostics := diag.Diagnostics{}
+	entity := cortex.ResourceDefinition{
+		Type:           "business-unit",
+		Name:           "Business Unit",
+		Source:         "CUSTOM",
+		Schema:         map[string]interface{}{"type": "object"},
+		DisplayName:    "Business Units",
+		IconTag:        "building",
+		ParentType:     "domain",
+		CanOwnEntities: true,
+	}
+
+	data := NewEntityTypeResourceModel()
+	data.FromApiModel(ctx, &diagnostics, entity)
+	assert.False(t, diagnostics.HasError())
+	assert.Equal(t, "business-unit", data.Id.ValueString())
+	assert.True(t, data.Description.IsNull())
+	assert.Equal(t, `{"type":"object"}`, data.Schema.ValueString())
+
+	assert.Equal(t, entity, data.ToApiModel(&diagnostics))
+	assert.False(t, diagnostics.HasError())
+}
+
+func TestEntityTypeResourceMoveStateFromResourceDefinition(t *testing.T) {
+	ctx := context.Background()
+	sourceSchema := resource.SchemaResponse{}
+	NewResourceDefinitionResource().Schema(ctx, resource.SchemaRequest{}, &sourceSchema)
+	targetSchema := resource.SchemaResponse{}
+	NewEntityTypeResource().Schema(ctx, resource.SchemaRequest{}, &targetSchema)
+
+	sourceState := &tfsdk.State{
+		Schema: sourceSchema.Schema,
+		Raw:    tftypes.NewValue(sourceSchema.Schema.Type().TerraformType(ctx), nil),
+	}
+	diagnostics := sourceState.Set(ctx, &ResourceDefinitionResourceModel{
+		Id:          types.StringValue("squid-proxy"),
+		Type:        types.StringValue("squid-proxy"),
+		Name:        types.StringValue("Squid Proxy"),
+		Description: types.StringNull(),
+		Source:      types.StringValue("CUSTOM"),
+		Schema:      types.StringValue(`{"type":"object"}`),
+	})
+	assert.False(t, diagnostics.HasError())
+
+	movers := NewEntityTypeResource().(*EntityTypeResource).MoveState(ctx)
+	assert.Len(t, movers, 1)
+
+	newResponse := func() *resource.MoveStateResponse {
+		return &resource.MoveStateResponse{
+			TargetState: tfsdk.State{
+				Schema: targetSchema.Schema,
+				Raw:    tftypes.NewValue(targetSchema.Schema.Type().TerraformType(ctx), nil),
+			},
+		}
+	}
+
+	// Resources other than cortex_resource_definition are skipped
+	resp := newResponse()
+	movers[0].StateMover(ctx, resource.MoveStateRequest{
+		SourceProviderAddress: "registry.terraform.io/cortexapps/cortex",
+		SourceTypeName:        "cortex_catalog_entity",
+		SourceState:           sourceState,
+	}, resp)
+	assert.False(t, resp.Diagnostics.HasError())
+	assert.True(t, resp.TargetState.Raw.IsNull())
+
+	resp = newResponse()
+	movers[0].StateMover(ctx, resource.MoveStateRequest{
+		SourceProviderAddress: "registry.terraform.io/cortexapps/cortex",
+		SourceTypeName:        "cortex_resource_definition",
+		SourceState:           sourceState,
+	}, resp)
+	assert.False(t, resp.Diagnostics.HasError())
+
+	data := NewEntityTypeResourceModel()
+	assert.False(t, resp.TargetState.Get(ctx, &data).HasError())
+	assert.Equal(t, "squid-proxy", data.Id.ValueString())
+	assert.Equal(t, "squid-proxy", data.Type.ValueString())
+	assert.Equal(t, "Squid Proxy", data.Name.ValueString())
+	assert.Equal(t, `{"type":"object"}`, data.Schema.ValueString())
+	assert.True(t, data.DisplayName.IsNull())
+	assert.False(t, data.CanOwnEntities.ValueBool())
+}
@@ -0,0 +1,108 @@
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEntityTypeResource(t *testing.T) {
+	tag := "test-entity-type"
+	resourceName := "cortex_entity_type." + tag
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccEntityTypeResourceConfig(tag, "Test Entity Types", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", tag),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "Test Entity Types"),
+					resource.TestCheckResourceAttr(resourceName, "icon", "building"),
+					resource.TestCheckResourceAttr(resourceName, "can_own_entities", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccEntityTypeResourceConfig(tag, "Updated Test Entity Types", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", tag),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "Updated Test Entity Types"),
+					resource.TestCheckResourceAttr(resourceName, "can_own_entities", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccEntityTypeResourceMovedFromResourceDefinition(t *testing.T) {
+	tag := "test-entity-type-moved"
+	stub := tFactoryBuildResourceDefinitionResource(tag, "A resource definition moved to an entity type.")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDefinitionResourceMinimalConfig(tag, stub),
+			},
+			{
+				Config: testAccEntityTypeResourceMovedConfig(tag, stub),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cortex_entity_type."+tag, "type", stub.Type),
+					resource.TestCheckResourceAttr("cortex_entity_type."+tag, "name", stub.Name),
+					resource.TestCheckResourceAttr("cortex_entity_type."+tag, "can_own_entities", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEntityTypeResourceConfig(tag string, displayName string, canOwnEntities bool) string {
+	return fmt.Sprintf(`
+resource "cortex_entity_type" %[1]q {
+  type             = %[1]q
+  name             = %[1]q
+  display_name     = %[2]q
+  icon             = "building"
+  can_own_entities = %[3]t
+  schema = jsonencode({
+    "type" : "object",
+    "properties" : {
+      "region" : { "type" : "string" }
+    }
+  })
+}
+`, tag, displayName, canOwnEntities)
+}
+
+func testAccEntityTypeResourceMovedConfig(tag string, stub TestResourceDefinitionResource) string {
+	return fmt.Sprintf(`
+moved {
+  from = cortex_resource_definition.%[1]s
+  to   = cortex_entity_type.%[1]s
+}
+
+resource "cortex_entity_type" %[1]q {
+  type        = %[2]q
+  name        = %[3]q
+  description = %[4]q
+  schema = jsonencode({
+    "properties" : {
+      "region" : { "type" : "string" }
+    },
+    "type" : "object"
+  })
+}
+`, tag, stub.Type, stub.Name, stub.Description)
+}
@@ -0,0 +1,268 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &EntityVerificationPeriodResource{}
+var _ resource.ResourceWithImportState = &EntityVerificationPeriodResource{}
+
+func NewEntityVerificationPeriodResource() resource.Resource {
+	return &EntityVerificationPeriodResource{}
+}
+
+/***********************************************************************************************************************
+ * Types
+ **********************************************************************************************************************/
+
+// EntityVerificationPeriodResource defines the resource implementation.
+type EntityVerificationPeriodResource struct {
+	client *cortex.HttpClient
+}
+
+/***********************************************************************************************************************
+ * Schema
+ **********************************************************************************************************************/
+
+func (r *EntityVerificationPeriodResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Entity Verification Period. Asks the owners of matching catalog entities to periodically verify that their entity's metadata is correct.",
+
+		Attributes: map[string]schema.Attribute{
+			// Required attributes
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the verification period.",
+				Required:            true,
+			},
+			"cadence": schema.StringAttribute{
+				MarkdownDescription: "How often entities must be verified: `MONTHLY`, `QUARTERLY`, `SEMI_ANNUALLY` or `ANNUALLY`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						cortex.EntityVerificationCadenceMonthly,
+						cortex.EntityVerificationCadenceQuarterly,
+						cortex.EntityVerificationCadenceSemiAnnually,
+						cortex.EntityVerificationCadenceAnnually,
+					),
+				},
+			},
+
+			// Optional attributes
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the verification period, shown to entity owners.",
+				Optional:            true,
+			},
+			"start_date": schema.StringAttribute{
+				MarkdownDescription: "Date the first period starts, in YYYY-MM-DD format. If omitted, the first period starts when the resource is created.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`), "must be a date in YYYY-MM-DD format"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"filter": schema.SingleNestedAttribute{
+				MarkdownDescription: "Entities that must be verified, in the same format as a scorecard's filter. If omitted, every entity must be verified.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"types": schema.SingleNestedAttribute{
+						MarkdownDescription: "Filter by entity types.",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"include": schema.SetAttribute{
+								MarkdownDescription: "Entity types that must be verified. Cannot be used with exclude.",
+								ElementType:         types.StringType,
+								Optional:            true,
+								Validators: []validator.Set{
+									setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("exclude")),
+								},
+							},
+							"exclude": schema.SetAttribute{
+								MarkdownDescription: "Entity types that don't need to be verified. Cannot be used with include.",
+								ElementType:         types.StringType,
+								Optional:            true,
+								Validators: []validator.Set{
+									setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("include")),
+								},
+							},
+						},
+					},
+					"groups": schema.SingleNestedAttribute{
+						MarkdownDescription: "Filter by entity groups.",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"include": schema.SetAttribute{
+								MarkdownDescription: "Entity groups that must be verified.",
+								ElementType:         types.StringType,
+								Optional:            true,
+							},
+							"exclude": schema.SetAttribute{
+								MarkdownDescription: "Entity groups that don't need to be verified.",
+								ElementType:         types.StringType,
+								Optional:            true,
+							},
+						},
+					},
+					"query": schema.StringAttribute{
+						MarkdownDescription: "A CQL query that is run against the filtered entities; only entities matching this query must be verified.",
+						Optional:            true,
+					},
+				},
+			},
+
+			// Computed attributes
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the verification period.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+/***********************************************************************************************************************
+ * Methods
+ **********************************************************************************************************************/
+
+func (r *EntityVerificationPeriodResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_entity_verification_period"
+}
+
+func (r *EntityVerificationPeriodResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cortex.HttpClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *EntityVerificationPeriodResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	data := NewEntityVerificationPeriodResourceModel()
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Issue API request
+	entity, err := r.client.EntityVerifications().GetPeriod(ctx, data.Id.ValueString())
+	if err != nil {
+		if errors.Is(err, cortex.ApiErrorNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read entity verification period %s, got error: %s", data.Id.ValueString(), err))
+		return
+	}
+
+	// Map data from the API response to the model
+	data.FromApiModel(ctx, &resp.Diagnostics, entity)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EntityVerificationPeriodResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	data := NewEntityVerificationPeriodResourceModel()
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientEntity := data.ToApiModel(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	entity, err := r.client.EntityVerifications().CreatePeriod(ctx, clientEntity.ToUpsertRequest())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create entity verification period, got error: %s", err))
+		return
+	}
+
+	data.FromApiModel(ctx, &resp.Diagnostics, entity)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EntityVerificationPeriodResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	data := NewEntityVerificationPeriodResourceModel()
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientEntity := data.ToApiModel(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	entity, err := r.client.EntityVerifications().UpdatePeriod(ctx, data.Id.ValueString(), clientEntity.ToUpsertRequest())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update entity verification period, got error: %s", err))
+		return
+	}
+
+	data.FromApiModel(ctx, &resp.Diagnostics, entity)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EntityVerificationPeriodResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	data := NewEntityVerificationPeriodResourceModel()
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.EntityVerifications().DeletePeriod(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete entity verification period, got error: %s", err))
+		return
+	}
+}
+
+func (r *EntityVerificationPeriodResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
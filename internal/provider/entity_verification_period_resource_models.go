@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+/***********************************************************************************************************************
+ * Models
+ **********************************************************************************************************************/
+
+// EntityVerificationPeriodResourceModel describes the entity verification period data model within Terraform. The
+// filter has the same shape as a scorecard's filter.
+type EntityVerificationPeriodResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Cadence     types.String `tfsdk:"cadence"`
+	StartDate   types.String `tfsdk:"start_date"`
+	Filter      types.Object `tfsdk:"filter"`
+}
+
+func NewEntityVerificationPeriodResourceModel() EntityVerificationPeriodResourceModel {
+	return EntityVerificationPeriodResourceModel{}
+}
+
+func (o *EntityVerificationPeriodResourceModel) ToApiModel(ctx context.Context, diagnostics *diag.Diagnostics) cortex.EntityVerificationPeriod {
+	entity := cortex.EntityVerificationPeriod{
+		Id:          o.Id.ValueString(),
+		Name:        o.Name.ValueString(),
+		Description: o.Description.ValueString(),
+		Cadence:     o.Cadence.ValueString(),
+		StartDate:   o.StartDate.ValueString(),
+	}
+	if !o.Filter.IsNull() && !o.Filter.IsUnknown() {
+		filter := ScorecardFilterResourceModel{}
+		diagnostics.Append(o.Filter.As(ctx, &filter, getDefaultObjectOptions())...)
+		entity.Filter = filter.ToApiModel(ctx, diagnostics)
+	}
+	return entity
+}
+
+func (o *EntityVerificationPeriodResourceModel) FromApiModel(ctx context.Context, diagnostics *diag.Diagnostics, entity cortex.EntityVerificationPeriod) {
+	o.Id = types.StringValue(entity.Id)
+	o.Name = types.StringValue(entity.Name)
+	o.Description = stringValueOrNull(entity.Description)
+	o.Cadence = types.StringValue(entity.Cadence)
+	o.StartDate = stringValueOrNull(entity.StartDate)
+
+	filter := ScorecardFilterResourceModel{}
+	o.Filter = filter.FromApiModel(ctx, diagnostics, &entity.Filter)
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEntityVerificationPeriodResourceModelRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	entity := cortex.EntityVerificationPeriod{
+		Id:        "evp-1",
+		Name:      "Quarterly service attestation",
+		Cadence:   cortex.EntityVerificationCadenceQuarterly,
+		StartDate: "2026-01-01",
+		Filter: cortex.ScorecardFilter{
+			Kind:   "GENERIC",
+			Types:  &cortex.ScorecardFilterTypes{Include: []string{"service"}},
+			Groups: &cortex.ScorecardFilterGroups{Exclude: []string{"deprecated"}},
+		},
+	}
+
+	diagnostics := diag.Diagnostics{}
+	model := NewEntityVerificationPeriodResourceModel()
+	model.FromApiModel(ctx, &diagnostics, entity)
+	assert.False(t, diagnostics.HasError())
+	assert.True(t, model.Description.IsNull())
+	assert.False(t, model.Filter.IsNull())
+
+	roundTrip := model.ToApiModel(ctx, &diagnostics)
+	assert.False(t, diagnostics.HasError())
+	assert.Equal(t, entity.Name, roundTrip.Name)
+	assert.Equal(t, entity.Cadence, roundTrip.Cadence)
+	assert.Equal(t, entity.StartDate, roundTrip.StartDate)
+	assert.Equal(t, []string{"service"}, roundTrip.Filter.Types.Include)
+	assert.Empty(t, roundTrip.Filter.Types.Exclude)
+	assert.Equal(t, []string{"deprecated"}, roundTrip.Filter.Groups.Exclude)
+}
+
+func TestEntityVerificationPeriodResourceModelWithoutFilter(t *testing.T) {
+	ctx := context.Background()
+	entity := cortex.EntityVerificationPeriod{
+		Id:      "evp-1",
+		Name:    "Annual attestation",
+		Cadence: cortex.EntityVerificationCadenceAnnually,
+	}
+
+	diagnostics := diag.Diagnostics{}
+	model := NewEntityVerificationPeriodResourceModel()
+	model.FromApiModel(ctx, &diagnostics, entity)
+	assert.False(t, diagnostics.HasError())
+	assert.True(t, model.Filter.IsNull())
+	assert.True(t, model.StartDate.IsNull())
+
+	assert.Equal(t, entity, model.ToApiModel(ctx, &diagnostics))
+}
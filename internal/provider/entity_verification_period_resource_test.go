@@ -0,0 +1,82 @@
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+type testEntityVerificationPeriodResource struct {
+	Name    string
+	Cadence string
+}
+
+func (t *testEntityVerificationPeriodResource) ResourceFullName() string {
+	return t.ResourceType() + ".test"
+}
+
+func (t *testEntityVerificationPeriodResource) ResourceType() string {
+	return "cortex_entity_verification_period"
+}
+
+func (t *testEntityVerificationPeriodResource) ToTerraform() string {
+	return fmt.Sprintf(`
+resource %[1]q "test" {
+  name       = %[2]q
+  cadence    = %[3]q
+  start_date = "2026-01-01"
+
+  filter = {
+    types = {
+      include = ["service"]
+    }
+  }
+}
+
+data "cortex_entity_verification_statuses" "test" {
+  period_id = %[1]s.test.id
+}`, t.ResourceType(), t.Name, t.Cadence)
+}
+
+func TestAccEntityVerificationPeriodResource(t *testing.T) {
+	stub := testEntityVerificationPeriodResource{
+		Name:    "Terraform test attestation",
+		Cadence: "QUARTERLY",
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: stub.ToTerraform(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(stub.ResourceFullName(), "id"),
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "name", stub.Name),
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "cadence", stub.Cadence),
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "filter.types.include.#", "1"),
+					resource.TestCheckResourceAttrSet("data.cortex_entity_verification_statuses.test", "statuses.#"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      stub.ResourceFullName(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: func() string {
+					updated := stub
+					updated.Cadence = "ANNUALLY"
+					return updated.ToTerraform()
+				}(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "cadence", "ANNUALLY"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
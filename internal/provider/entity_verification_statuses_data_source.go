@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &EntityVerificationStatusesDataSource{}
+
+func NewEntityVerificationStatusesDataSource() datasource.DataSource {
+	return &EntityVerificationStatusesDataSource{}
+}
+
+// EntityVerificationStatusesDataSource defines the data source implementation.
+type EntityVerificationStatusesDataSource struct {
+	client *cortex.HttpClient
+}
+
+// EntityVerificationStatusesDataSourceModel describes the data source data model.
+type EntityVerificationStatusesDataSourceModel struct {
+	Id       types.String                                  `tfsdk:"id"`
+	PeriodId types.String                                  `tfsdk:"period_id"`
+	Status   types.String                                  `tfsdk:"status"`
+	Statuses []EntityVerificationStatusDataSourceItemModel `tfsdk:"statuses"`
+}
+
+// EntityVerificationStatusDataSourceItemModel is the verification status of a single entity.
+type EntityVerificationStatusDataSourceItemModel struct {
+	EntityTag  types.String `tfsdk:"entity_tag"`
+	PeriodId   types.String `tfsdk:"period_id"`
+	Status     types.String `tfsdk:"status"`
+	VerifiedAt types.String `tfsdk:"verified_at"`
+	VerifiedBy types.String `tfsdk:"verified_by"`
+	ExpiresAt  types.String `tfsdk:"expires_at"`
+}
+
+func (d *EntityVerificationStatusesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_entity_verification_statuses"
+}
+
+func (d *EntityVerificationStatusesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Entity Verification Statuses data source - returns whether each entity has been verified in its current verification period",
+
+		Attributes: map[string]schema.Attribute{
+			// Optional
+			"period_id": schema.StringAttribute{
+				MarkdownDescription: "Only return entities in this verification period",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Only return entities with this status: `VERIFIED`, `UNVERIFIED` or `EXPIRED`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						cortex.EntityVerificationStatusVerified,
+						cortex.EntityVerificationStatusUnverified,
+						cortex.EntityVerificationStatusExpired,
+					),
+				},
+			},
+
+			// Computed
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Internal identifier for this data source",
+				Computed:            true,
+			},
+			"statuses": schema.ListNestedAttribute{
+				MarkdownDescription: "Verification status of each entity",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"entity_tag": schema.StringAttribute{
+							MarkdownDescription: "Tag of the entity",
+							Computed:            true,
+						},
+						"period_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the verification period",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Verification status: `VERIFIED`, `UNVERIFIED` or `EXPIRED`",
+							Computed:            true,
+						},
+						"verified_at": schema.StringAttribute{
+							MarkdownDescription: "When the entity was last verified, in RFC 3339 format",
+							Computed:            true,
+						},
+						"verified_by": schema.StringAttribute{
+							MarkdownDescription: "Email of the user who last verified the entity",
+							Computed:            true,
+						},
+						"expires_at": schema.StringAttribute{
+							MarkdownDescription: "When the current verification expires, in RFC 3339 format",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *EntityVerificationStatusesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cortex.HttpClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *EntityVerificationStatusesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data EntityVerificationStatusesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &cortex.EntityVerificationStatusListParams{
+		PeriodId: data.PeriodId.ValueString(),
+		Status:   data.Status.ValueString(),
+		PageSize: 250,
+		Page:     0,
+	}
+
+	// Fetch all pages of results
+	statuses := []EntityVerificationStatusDataSourceItemModel{}
+	for {
+		statusesResponse, err := d.client.EntityVerifications().ListStatuses(ctx, params)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read entity verification statuses, got error: %s", err))
+			return
+		}
+
+		for _, status := range statusesResponse.Statuses {
+			statuses = append(statuses, EntityVerificationStatusDataSourceItemModel{
+				EntityTag:  types.StringValue(status.EntityTag),
+				PeriodId:   types.StringValue(status.PeriodId),
+				Status:     types.StringValue(status.Status),
+				VerifiedAt: stringValueOrNull(status.VerifiedAt),
+				VerifiedBy: stringValueOrNull(status.VerifiedBy),
+				ExpiresAt:  stringValueOrNull(status.ExpiresAt),
+			})
+		}
+
+		if statusesResponse.Page >= statusesResponse.TotalPages-1 || len(statusesResponse.Statuses) == 0 {
+			break
+		}
+		params.Page++
+	}
+
+	data.Id = types.StringValue("entity_verification_statuses")
+	data.Statuses = statuses
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &InitiativeDataSource{}
+
+func NewInitiativeDataSource() datasource.DataSource {
+	return &InitiativeDataSource{}
+}
+
+// InitiativeDataSource defines the data source implementation.
+type InitiativeDataSource struct {
+	client *cortex.HttpClient
+}
+
+func (d *InitiativeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_initiative"
+}
+
+func (d *InitiativeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Initiative data source",
+
+		Attributes: map[string]schema.Attribute{
+			// Required
+			"id": schema.StringAttribute{
+				MarkdownDescription: "CID of the initiative",
+				Required:            true,
+			},
+
+			// Computed
+			"name": schema.StringAttribute{
+				Computed: true,
+			},
+			"description": schema.StringAttribute{
+				Computed: true,
+			},
+			"scorecard_tag": schema.StringAttribute{
+				Computed: true,
+			},
+			"target_date": schema.StringAttribute{
+				Computed: true,
+			},
+			"draft": schema.BoolAttribute{
+				Computed: true,
+			},
+			"levels": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"rules": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *InitiativeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cortex.HttpClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *InitiativeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data InitiativeDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entity, err := d.client.Initiatives().Get(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read initiative, got error: %s", err))
+		return
+	}
+
+	// Map entity to resource model
+	data.FromApiModel(entity)
+
+	// Write to TF state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
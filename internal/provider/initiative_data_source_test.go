@@ -0,0 +1,35 @@
+package provider_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccInitiativeDataSource(t *testing.T) {
+	stub := testInitiativeResource{
+		Name:         "Test Initiative Data Source",
+		ScorecardTag: "test-initiative-data-source-scorecard",
+		TargetDate:   "2030-01-01",
+	}
+	recordName := "data.cortex_initiative.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: stub.ToTerraform() + `
+data "cortex_initiative" "test" {
+  id = cortex_initiative.test.id
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(recordName, "id", stub.ResourceFullName(), "id"),
+					resource.TestCheckResourceAttr(recordName, "name", stub.Name),
+					resource.TestCheckResourceAttr(recordName, "scorecard_tag", stub.ScorecardTag),
+					resource.TestCheckResourceAttr(recordName, "target_date", stub.TargetDate),
+					resource.TestCheckResourceAttr(recordName, "levels.0", "Bronze"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,310 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &InitiativeResource{}
+var _ resource.ResourceWithImportState = &InitiativeResource{}
+
+func NewInitiativeResource() resource.Resource {
+	return &InitiativeResource{}
+}
+
+/***********************************************************************************************************************
+ * Types
+ **********************************************************************************************************************/
+
+// InitiativeResource defines the resource implementation.
+type InitiativeResource struct {
+	client *cortex.HttpClient
+}
+
+/***********************************************************************************************************************
+ * Schema
+ **********************************************************************************************************************/
+
+func (r *InitiativeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Initiative Entity. Initiatives set a deadline for entities to reach levels or pass rules on a scorecard.",
+
+		Attributes: map[string]schema.Attribute{
+			// Required attributes
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the initiative.",
+				Required:            true,
+			},
+			"scorecard_tag": schema.StringAttribute{
+				MarkdownDescription: "Tag of the scorecard the initiative targets.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_date": schema.StringAttribute{
+				MarkdownDescription: "Date by which entities should meet the initiative, in `YYYY-MM-DD` format.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`), "must be a date in YYYY-MM-DD format"),
+				},
+			},
+
+			// Optional attributes
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the initiative.",
+				Optional:            true,
+			},
+			"draft": schema.BoolAttribute{
+				MarkdownDescription: "Whether the initiative is a draft.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"levels": schema.SetAttribute{
+				MarkdownDescription: "Names of the scorecard ladder levels that entities should reach. At least one of `levels` or `rules` is required.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.AtLeastOneOf(path.MatchRoot("rules")),
+				},
+			},
+			"rules": schema.SetAttribute{
+				MarkdownDescription: "Expressions of the scorecard rules that entities should pass. At least one of `levels` or `rules` is required.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"filter": schema.SingleNestedAttribute{
+				MarkdownDescription: "Filter of the entities the initiative applies to. Defaults to every entity evaluated by the scorecard.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"types": schema.SingleNestedAttribute{
+						MarkdownDescription: "Filter by entity types.",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"include": schema.SetAttribute{
+								MarkdownDescription: "Entity types to include in the initiative. Cannot be used with exclude.",
+								ElementType:         types.StringType,
+								Optional:            true,
+								Validators: []validator.Set{
+									setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("exclude")),
+								},
+							},
+							"exclude": schema.SetAttribute{
+								MarkdownDescription: "Entity types to exclude from the initiative. Cannot be used with include.",
+								ElementType:         types.StringType,
+								Optional:            true,
+								Validators: []validator.Set{
+									setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("include")),
+								},
+							},
+						},
+					},
+					"groups": schema.SingleNestedAttribute{
+						MarkdownDescription: "Filter by entity groups.",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"include": schema.SetAttribute{
+								MarkdownDescription: "Entity groups to include in the initiative.",
+								ElementType:         types.StringType,
+								Optional:            true,
+							},
+							"exclude": schema.SetAttribute{
+								MarkdownDescription: "Entity groups to exclude from the initiative.",
+								ElementType:         types.StringType,
+								Optional:            true,
+							},
+						},
+					},
+					"query": schema.StringAttribute{
+						MarkdownDescription: "A CQL query that entities must match to be part of the initiative.",
+						Optional:            true,
+					},
+				},
+			},
+			"notifications": schema.SingleNestedAttribute{
+				MarkdownDescription: "Notification settings for entity owners that have not yet met the initiative.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						MarkdownDescription: "Whether owners are notified about the initiative.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(true),
+					},
+					"interval": schema.Int64Attribute{
+						MarkdownDescription: "Number of `interval_unit`s between notifications.",
+						Optional:            true,
+					},
+					"interval_unit": schema.StringAttribute{
+						MarkdownDescription: "Unit of `interval`. One of `DAYS`, `WEEKS` or `MONTHS`.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("DAYS", "WEEKS", "MONTHS"),
+						},
+					},
+					"reply_to_emails": schema.SetAttribute{
+						MarkdownDescription: "Email addresses that replies to notifications are sent to.",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+				},
+			},
+
+			// Computed attributes
+			"id": schema.StringAttribute{
+				MarkdownDescription: "CID of the initiative.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+/***********************************************************************************************************************
+ * Methods
+ **********************************************************************************************************************/
+
+func (r *InitiativeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_initiative"
+}
+
+func (r *InitiativeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cortex.HttpClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *InitiativeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	data := NewInitiativeResourceModel()
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Issue API request
+	entity, err := r.client.Initiatives().Get(ctx, data.Id.ValueString())
+	if err != nil {
+		if errors.Is(err, cortex.ApiErrorNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read initiative %s, got error: %s", data.Id.ValueString(), err))
+		return
+	}
+
+	// Map data from the API response to the model
+	data.FromApiModel(ctx, &resp.Diagnostics, entity)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create Creates a new initiative.
+func (r *InitiativeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	data := NewInitiativeResourceModel()
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientEntity := data.ToApiModel(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entity, err := r.client.Initiatives().Create(ctx, clientEntity.ToCreateRequest())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create initiative, got error: %s", err))
+		return
+	}
+
+	data.FromApiModel(ctx, &resp.Diagnostics, entity)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InitiativeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	data := NewInitiativeResourceModel()
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientEntity := data.ToApiModel(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entity, err := r.client.Initiatives().Update(ctx, data.Id.ValueString(), clientEntity.ToUpdateRequest())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update initiative, got error: %s", err))
+		return
+	}
+
+	data.FromApiModel(ctx, &resp.Diagnostics, entity)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InitiativeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	data := NewInitiativeResourceModel()
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Initiatives().Delete(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete initiative, got error: %s", err))
+		return
+	}
+}
+
+func (r *InitiativeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+/***********************************************************************************************************************
+ * Models
+ **********************************************************************************************************************/
+
+// InitiativeResourceModel describes the initiative data model within Terraform.
+type InitiativeResourceModel struct {
+	Id            types.String   `tfsdk:"id"`
+	Name          types.String   `tfsdk:"name"`
+	Description   types.String   `tfsdk:"description"`
+	ScorecardTag  types.String   `tfsdk:"scorecard_tag"`
+	TargetDate    types.String   `tfsdk:"target_date"`
+	Draft         types.Bool     `tfsdk:"draft"`
+	Levels        []types.String `tfsdk:"levels"`
+	Rules         []types.String `tfsdk:"rules"`
+	Filter        types.Object   `tfsdk:"filter"`
+	Notifications types.Object   `tfsdk:"notifications"`
+}
+
+type InitiativeNotificationsResourceModel struct {
+	Enabled       types.Bool     `tfsdk:"enabled"`
+	Interval      types.Int64    `tfsdk:"interval"`
+	IntervalUnit  types.String   `tfsdk:"interval_unit"`
+	ReplyToEmails []types.String `tfsdk:"reply_to_emails"`
+}
+
+func NewInitiativeResourceModel() InitiativeResourceModel {
+	return InitiativeResourceModel{}
+}
+
+func (o *InitiativeResourceModel) ToApiModel(ctx context.Context, diagnostics *diag.Diagnostics) cortex.Initiative {
+	defaultObjOptions := getDefaultObjectOptions()
+
+	entity := cortex.Initiative{
+		Cid:              o.Id.ValueString(),
+		Name:             o.Name.ValueString(),
+		Description:      o.Description.ValueString(),
+		ScorecardTag:     o.ScorecardTag.ValueString(),
+		TargetDate:       o.TargetDate.ValueString(),
+		IsDraft:          o.Draft.ValueBool(),
+		EmphasizedLevels: make([]cortex.InitiativeLevel, len(o.Levels)),
+		EmphasizedRules:  make([]cortex.InitiativeRule, len(o.Rules)),
+	}
+	for i, level := range o.Levels {
+		entity.EmphasizedLevels[i] = cortex.InitiativeLevel{LevelName: level.ValueString()}
+	}
+	for i, rule := range o.Rules {
+		entity.EmphasizedRules[i] = cortex.InitiativeRule{Expression: rule.ValueString()}
+	}
+
+	if !o.Filter.IsNull() && !o.Filter.IsUnknown() {
+		filter := ScorecardFilterResourceModel{}
+		err := o.Filter.As(ctx, &filter, defaultObjOptions)
+		if err != nil {
+			diagnostics.AddError("error parsing initiative filter", fmt.Sprintf("%+v", err))
+		} else {
+			f := filter.ToApiModel(ctx, diagnostics)
+			entity.Filter = &f
+		}
+	}
+
+	if !o.Notifications.IsNull() && !o.Notifications.IsUnknown() {
+		notifications := InitiativeNotificationsResourceModel{}
+		err := o.Notifications.As(ctx, &notifications, defaultObjOptions)
+		if err != nil {
+			diagnostics.AddError("error parsing initiative notifications", fmt.Sprintf("%+v", err))
+		} else {
+			entity.NotificationSchedule = notifications.ToApiModel()
+		}
+	}
+
+	return entity
+}
+
+func (o *InitiativeResourceModel) FromApiModel(ctx context.Context, diagnostics *diag.Diagnostics, entity cortex.Initiative) {
+	o.Id = types.StringValue(entity.Cid)
+	o.Name = types.StringValue(entity.Name)
+	if entity.Description != "" {
+		o.Description = types.StringValue(entity.Description)
+	} else {
+		o.Description = types.StringNull()
+	}
+	o.ScorecardTag = types.StringValue(entity.ScorecardTag)
+	o.TargetDate = types.StringValue(entity.TargetDate)
+	o.Draft = types.BoolValue(entity.IsDraft)
+
+	o.Levels = nil
+	for _, level := range entity.EmphasizedLevels {
+		o.Levels = append(o.Levels, types.StringValue(level.LevelName))
+	}
+	o.Rules = nil
+	for _, rule := range entity.EmphasizedRules {
+		o.Rules = append(o.Rules, types.StringValue(rule.Expression))
+	}
+
+	filter := ScorecardFilterResourceModel{}
+	if entity.Filter != nil {
+		o.Filter = filter.FromApiModel(ctx, diagnostics, entity.Filter)
+	} else {
+		o.Filter = types.ObjectNull(filter.AttrTypes())
+	}
+
+	// The API always returns a notification schedule; only track it in state if it was configured, or if it differs
+	// from the default.
+	notifications := InitiativeNotificationsResourceModel{}
+	if entity.NotificationSchedule.Configured() || (!o.Notifications.IsNull() && entity.NotificationSchedule != nil) {
+		o.Notifications = notifications.FromApiModel(ctx, diagnostics, entity.NotificationSchedule)
+	} else {
+		o.Notifications = types.ObjectNull(notifications.AttrTypes())
+	}
+}
+
+/***********************************************************************************************************************
+ * Notifications
+ **********************************************************************************************************************/
+
+func (o *InitiativeNotificationsResourceModel) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"enabled":         types.BoolType,
+		"interval":        types.Int64Type,
+		"interval_unit":   types.StringType,
+		"reply_to_emails": types.SetType{ElemType: types.StringType},
+	}
+}
+
+func (o *InitiativeNotificationsResourceModel) ToApiModel() *cortex.InitiativeNotificationSchedule {
+	replyToEmails := make([]string, len(o.ReplyToEmails))
+	for i, email := range o.ReplyToEmails {
+		replyToEmails[i] = email.ValueString()
+	}
+	return &cortex.InitiativeNotificationSchedule{
+		IsDisabled:    !o.Enabled.ValueBool(),
+		TimeInterval:  o.Interval.ValueInt64(),
+		TimeUnit:      o.IntervalUnit.ValueString(),
+		ReplyToEmails: replyToEmails,
+	}
+}
+
+func (o *InitiativeNotificationsResourceModel) FromApiModel(ctx context.Context, diagnostics *diag.Diagnostics, entity *cortex.InitiativeNotificationSchedule) types.Object {
+	obj := InitiativeNotificationsResourceModel{
+		Enabled: types.BoolValue(!entity.IsDisabled),
+	}
+	if entity.TimeInterval > 0 {
+		obj.Interval = types.Int64Value(entity.TimeInterval)
+	} else {
+		obj.Interval = types.Int64Null()
+	}
+	if entity.TimeUnit != "" {
+		obj.IntervalUnit = types.StringValue(entity.TimeUnit)
+	} else {
+		obj.IntervalUnit = types.StringNull()
+	}
+	for _, email := range entity.ReplyToEmails {
+		obj.ReplyToEmails = append(obj.ReplyToEmails, types.StringValue(email))
+	}
+
+	objectValue, d := types.ObjectValueFrom(ctx, obj.AttrTypes(), &obj)
+	diagnostics.Append(d...)
+	return objectValue
+}
+
+/***********************************************************************************************************************
+ * Data Source
+ **********************************************************************************************************************/
+
+// InitiativeDataSourceModel describes the data source data model.
+type InitiativeDataSourceModel struct {
+	Id           types.String   `tfsdk:"id"`
+	Name         types.String   `tfsdk:"name"`
+	Description  types.String   `tfsdk:"description"`
+	ScorecardTag types.String   `tfsdk:"scorecard_tag"`
+	TargetDate   types.String   `tfsdk:"target_date"`
+	Draft        types.Bool     `tfsdk:"draft"`
+	Levels       []types.String `tfsdk:"levels"`
+	Rules        []types.String `tfsdk:"rules"`
+}
+
+func (o *InitiativeDataSourceModel) FromApiModel(entity cortex.Initiative) {
+	o.Id = types.StringValue(entity.Cid)
+	o.Name = types.StringValue(entity.Name)
+	o.Description = types.StringValue(entity.Description)
+	o.ScorecardTag = types.StringValue(entity.ScorecardTag)
+	o.TargetDate = types.StringValue(entity.TargetDate)
+	o.Draft = types.BoolValue(entity.IsDraft)
+	o.Levels = make([]types.String, len(entity.EmphasizedLevels))
+	for i, level := range entity.EmphasizedLevels {
+		o.Levels[i] = types.StringValue(level.LevelName)
+	}
+	o.Rules = make([]types.String, len(entity.EmphasizedRules))
+	for i, rule := range entity.EmphasizedRules {
+		o.Rules[i] = types.StringValue(rule.Expression)
+	}
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInitiativeResourceModel_FromApiModel_Notifications(t *testing.T) {
+	ctx := context.Background()
+	notifications := InitiativeNotificationsResourceModel{}
+
+	tests := []struct {
+		name     string
+		prior    types.Object
+		schedule *cortex.InitiativeNotificationSchedule
+		isNull   bool
+	}{
+		{
+			name:     "default schedule is not tracked",
+			prior:    types.ObjectNull(notifications.AttrTypes()),
+			schedule: &cortex.InitiativeNotificationSchedule{},
+			isNull:   true,
+		},
+		{
+			name:     "missing schedule is not tracked",
+			prior:    types.ObjectNull(notifications.AttrTypes()),
+			schedule: nil,
+			isNull:   true,
+		},
+		{
+			name:     "configured schedule is tracked",
+			prior:    types.ObjectNull(notifications.AttrTypes()),
+			schedule: &cortex.InitiativeNotificationSchedule{TimeInterval: 1, TimeUnit: "WEEKS"},
+		},
+		{
+			name: "default schedule is tracked when configured",
+			prior: types.ObjectValueMust(notifications.AttrTypes(), map[string]attr.Value{
+				"enabled":         types.BoolValue(true),
+				"interval":        types.Int64Null(),
+				"interval_unit":   types.StringNull(),
+				"reply_to_emails": types.SetNull(types.StringType),
+			}),
+			schedule: &cortex.InitiativeNotificationSchedule{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := diag.Diagnostics{}
+			model := InitiativeResourceModel{Notifications: tt.prior}
+			model.FromApiModel(ctx, &diags, cortex.Initiative{Cid: "cid", NotificationSchedule: tt.schedule})
+			assert.False(t, diags.HasError())
+			assert.Equal(t, tt.isNull, model.Notifications.IsNull())
+		})
+	}
+}
+
+func TestInitiativeResourceModel_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	entity := cortex.Initiative{
+		Cid:              "cid",
+		Name:             "Q3 Gold",
+		ScorecardTag:     "dora",
+		TargetDate:       "2026-09-30",
+		EmphasizedLevels: []cortex.InitiativeLevel{{LevelName: "Gold"}},
+		EmphasizedRules:  []cortex.InitiativeRule{{Expression: "git != null"}},
+		Filter:           &cortex.ScorecardFilter{Kind: "GENERIC", Query: "entity.tag() != null"},
+		NotificationSchedule: &cortex.InitiativeNotificationSchedule{
+			IsDisabled:    true,
+			ReplyToEmails: []string{"sre@cortex.io"},
+		},
+	}
+
+	diags := diag.Diagnostics{}
+	model := NewInitiativeResourceModel()
+	model.FromApiModel(ctx, &diags, entity)
+	assert.False(t, diags.HasError())
+	assert.True(t, model.Description.IsNull())
+
+	actual := model.ToApiModel(ctx, &diags)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, entity.Cid, actual.Cid)
+	assert.Equal(t, entity.EmphasizedLevels, actual.EmphasizedLevels)
+	assert.Equal(t, entity.EmphasizedRules, actual.EmphasizedRules)
+	assert.Equal(t, entity.Filter.Query, actual.Filter.Query)
+	assert.Equal(t, entity.NotificationSchedule, actual.NotificationSchedule)
+}
@@ -0,0 +1,152 @@
+package provider_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+type testInitiativeResource struct {
+	Name         string
+	ScorecardTag string
+	TargetDate   string
+}
+
+/***********************************************************************************************************************
+ * Helper methods
+ **********************************************************************************************************************/
+
+func (t *testInitiativeResource) ResourceFullName() string {
+	return t.ResourceType() + ".test"
+}
+
+func (t *testInitiativeResource) ResourceType() string {
+	return "cortex_initiative"
+}
+
+func (t *testInitiativeResource) scorecardToTerraform() string {
+	return fmt.Sprintf(`
+resource "cortex_scorecard" %[1]q {
+  tag = %[1]q
+  name = "Test Initiative Scorecard"
+  rules = [
+    {
+      title = "Has a Description"
+      expression = "entity.description() != null"
+      weight = 1
+      level = "Bronze"
+    }
+  ]
+  ladder = {
+    levels = [
+      {
+         name = "Bronze"
+         rank = 1
+         color = "#c38b5f"
+      }
+    ]
+  }
+}`, t.ScorecardTag)
+}
+
+func (t *testInitiativeResource) ToTerraform() string {
+	return t.scorecardToTerraform() + fmt.Sprintf(`
+resource %[1]q "test" {
+  name = %[2]q
+  description = "Get every service to Bronze"
+  scorecard_tag = cortex_scorecard.%[3]s.tag
+  target_date = %[4]q
+  levels = ["Bronze"]
+}`, t.ResourceType(), t.Name, t.ScorecardTag, t.TargetDate)
+}
+
+func (t *testInitiativeResource) ToTerraformWithRulesAndNotifications() string {
+	return t.scorecardToTerraform() + fmt.Sprintf(`
+resource %[1]q "test" {
+  name = %[2]q
+  scorecard_tag = cortex_scorecard.%[3]s.tag
+  target_date = %[4]q
+  rules = ["entity.description() != null"]
+  filter = {
+    types = {
+      include = ["service"]
+    }
+  }
+  notifications = {
+    interval = 1
+    interval_unit = "WEEKS"
+    reply_to_emails = ["sre@cortex.io"]
+  }
+}`, t.ResourceType(), t.Name, t.ScorecardTag, t.TargetDate)
+}
+
+/***********************************************************************************************************************
+ * Tests
+ **********************************************************************************************************************/
+
+func TestAccInitiativeResource(t *testing.T) {
+	stub := testInitiativeResource{
+		Name:         "Test Initiative",
+		ScorecardTag: "test-initiative-scorecard",
+		TargetDate:   "2030-01-01",
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: stub.ToTerraform(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(stub.ResourceFullName(), "id"),
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "name", stub.Name),
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "description", "Get every service to Bronze"),
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "scorecard_tag", stub.ScorecardTag),
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "target_date", stub.TargetDate),
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "draft", "false"),
+					resource.TestCheckTypeSetElemAttr(stub.ResourceFullName(), "levels.*", "Bronze"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      stub.ResourceFullName(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: stub.ToTerraformWithRulesAndNotifications(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "name", stub.Name),
+					resource.TestCheckTypeSetElemAttr(stub.ResourceFullName(), "rules.*", "entity.description() != null"),
+					resource.TestCheckTypeSetElemAttr(stub.ResourceFullName(), "filter.types.include.*", "service"),
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "notifications.enabled", "true"),
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "notifications.interval", "1"),
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "notifications.interval_unit", "WEEKS"),
+					resource.TestCheckTypeSetElemAttr(stub.ResourceFullName(), "notifications.reply_to_emails.*", "sre@cortex.io"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccInitiativeResourceLevelsOrRulesValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "cortex_initiative" "test" {
+  name          = "Validation Test"
+  scorecard_tag = "test-validation"
+  target_date   = "2030-01-01"
+}`,
+				ExpectError: regexp.MustCompile(`At least one attribute out of \[(levels|rules),(levels|rules)\] must be specified`),
+			},
+		},
+	})
+}
@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &IntegrationDatadogResource{}
+var _ resource.ResourceWithImportState = &IntegrationDatadogResource{}
+
+func NewIntegrationDatadogResource() resource.Resource {
+	return &IntegrationDatadogResource{
+		integrationResource: integrationResource{integration: cortex.IntegrationDatadog, name: "Datadog"},
+	}
+}
+
+/***********************************************************************************************************************
+ * Types
+ **********************************************************************************************************************/
+
+// IntegrationDatadogResource defines the resource implementation.
+type IntegrationDatadogResource struct {
+	integrationResource
+}
+
+// IntegrationDatadogResourceModel describes the Datadog configuration data model within Terraform.
+type IntegrationDatadogResourceModel struct {
+	Id               types.String `tfsdk:"id"`
+	Alias            types.String `tfsdk:"alias"`
+	IsDefault        types.Bool   `tfsdk:"is_default"`
+	SecretsWoVersion types.Int64  `tfsdk:"secrets_wo_version"`
+	ApiKeyWo         types.String `tfsdk:"api_key_wo"`
+	AppKeyWo         types.String `tfsdk:"app_key_wo"`
+	Region           types.String `tfsdk:"region"`
+	CustomSubdomain  types.String `tfsdk:"custom_subdomain"`
+}
+
+var _ integrationResourceModel = &IntegrationDatadogResourceModel{}
+
+func (o *IntegrationDatadogResourceModel) alias() string {
+	return o.Alias.ValueString()
+}
+
+func (o *IntegrationDatadogResourceModel) ToApiModel() cortex.IntegrationConfiguration {
+	return cortex.IntegrationConfiguration{
+		"alias":           o.Alias.ValueString(),
+		"isDefault":       o.IsDefault.ValueBool(),
+		"apiKey":          o.ApiKeyWo.ValueString(),
+		"appKey":          o.AppKeyWo.ValueString(),
+		"region":          o.Region.ValueString(),
+		"customSubdomain": o.CustomSubdomain.ValueString(),
+	}
+}
+
+func (o *IntegrationDatadogResourceModel) FromApiModel(config cortex.IntegrationConfiguration) {
+	o.Id = types.StringValue(config.Alias())
+	o.Alias = types.StringValue(config.Alias())
+	o.IsDefault = types.BoolValue(config.IsDefault())
+	if region := config.String("region"); region != "" {
+		o.Region = types.StringValue(region)
+	} else {
+		o.Region = types.StringValue("US1")
+	}
+	o.CustomSubdomain = stringValueOrNull(config.String("customSubdomain"))
+}
+
+/***********************************************************************************************************************
+ * Schema
+ **********************************************************************************************************************/
+
+func (r *IntegrationDatadogResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Datadog Integration. Configures a Datadog account, referenced by the `datadog` APM, dashboard and SLO settings of catalog entities. Requires Terraform 1.11 or later, for write-only secrets.",
+
+		Attributes: integrationResourceAttributes("Datadog", map[string]schema.Attribute{
+			"api_key_wo": schema.StringAttribute{
+				MarkdownDescription: "Datadog API key. Write-only: it is sent to Cortex but never stored in state.",
+				Required:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"app_key_wo": schema.StringAttribute{
+				MarkdownDescription: "Datadog application key. Write-only: it is sent to Cortex but never stored in state.",
+				Required:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Datadog site of the account: `US1`, `US3`, `US5`, `EU1`, `AP1` or `US1_FED`. Defaults to `US1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("US1"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("US1", "US3", "US5", "EU1", "AP1", "US1_FED"),
+				},
+			},
+			"custom_subdomain": schema.StringAttribute{
+				MarkdownDescription: "Custom subdomain of the account, used in links to Datadog.",
+				Optional:            true,
+			},
+		}),
+	}
+}
+
+/***********************************************************************************************************************
+ * Methods
+ **********************************************************************************************************************/
+
+func (r *IntegrationDatadogResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration_datadog"
+}
+
+func (r *IntegrationDatadogResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	data := IntegrationDatadogResourceModel{}
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.read(ctx, &resp.Diagnostics, &data) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IntegrationDatadogResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	data := IntegrationDatadogResourceModel{}
+
+	// Read Terraform plan data into the model, and write-only secrets from the configuration
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("api_key_wo"), &data.ApiKeyWo)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("app_key_wo"), &data.AppKeyWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.create(ctx, &resp.Diagnostics, &data)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IntegrationDatadogResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	data := IntegrationDatadogResourceModel{}
+
+	// Read Terraform plan data into the model, and write-only secrets from the configuration
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("api_key_wo"), &data.ApiKeyWo)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("app_key_wo"), &data.AppKeyWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.update(ctx, &resp.Diagnostics, &data)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
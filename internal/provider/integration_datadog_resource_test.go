@@ -0,0 +1,83 @@
+package provider_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+type testIntegrationDatadogResource struct {
+	Alias   string
+	Region  string
+	Version int
+}
+
+func (t *testIntegrationDatadogResource) ResourceFullName() string {
+	return t.ResourceType() + "." + t.Alias
+}
+
+func (t *testIntegrationDatadogResource) ResourceType() string {
+	return "cortex_integration_datadog"
+}
+
+func (t *testIntegrationDatadogResource) ToTerraform() string {
+	return fmt.Sprintf(`
+resource %[1]q %[2]q {
+  alias              = %[2]q
+  region             = %[3]q
+  api_key_wo         = %[4]q
+  app_key_wo         = %[5]q
+  secrets_wo_version = %[6]d
+}`, t.ResourceType(), t.Alias, t.Region, os.Getenv("DATADOG_API_KEY"), os.Getenv("DATADOG_APP_KEY"), t.Version)
+}
+
+func TestAccIntegrationDatadogResource(t *testing.T) {
+	if os.Getenv("DATADOG_API_KEY") == "" || os.Getenv("DATADOG_APP_KEY") == "" {
+		t.Skip("DATADOG_API_KEY and DATADOG_APP_KEY must be set for Datadog integration acceptance tests")
+	}
+	stub := testIntegrationDatadogResource{
+		Alias:   "terraform-test-datadog",
+		Region:  "US1",
+		Version: 1,
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: stub.ToTerraform(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "id", stub.Alias),
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "region", stub.Region),
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "is_default", "false"),
+					resource.TestCheckNoResourceAttr(stub.ResourceFullName(), "api_key_wo"),
+					resource.TestCheckNoResourceAttr(stub.ResourceFullName(), "app_key_wo"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         stub.ResourceFullName(),
+				ImportState:                          true,
+				ImportStateId:                        stub.Alias,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "alias",
+				ImportStateVerifyIgnore:              []string{"secrets_wo_version"},
+			},
+			// Update and Read testing
+			{
+				Config: func() string {
+					updated := stub
+					updated.Version = 2
+					return updated.ToTerraform()
+				}(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "secrets_wo_version", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &IntegrationGithubAppResource{}
+var _ resource.ResourceWithImportState = &IntegrationGithubAppResource{}
+
+func NewIntegrationGithubAppResource() resource.Resource {
+	return &IntegrationGithubAppResource{
+		integrationResource: integrationResource{integration: cortex.IntegrationGithubApp, name: "GitHub app"},
+	}
+}
+
+/***********************************************************************************************************************
+ * Types
+ **********************************************************************************************************************/
+
+// IntegrationGithubAppResource defines the resource implementation.
+type IntegrationGithubAppResource struct {
+	integrationResource
+}
+
+// IntegrationGithubAppResourceModel describes the GitHub app configuration data model within Terraform.
+type IntegrationGithubAppResourceModel struct {
+	Id               types.String `tfsdk:"id"`
+	Alias            types.String `tfsdk:"alias"`
+	IsDefault        types.Bool   `tfsdk:"is_default"`
+	SecretsWoVersion types.Int64  `tfsdk:"secrets_wo_version"`
+	AppId            types.String `tfsdk:"app_id"`
+	ClientId         types.String `tfsdk:"client_id"`
+	ClientSecretWo   types.String `tfsdk:"client_secret_wo"`
+	PrivateKeyWo     types.String `tfsdk:"private_key_wo"`
+	ApiHost          types.String `tfsdk:"api_host"`
+}
+
+var _ integrationResourceModel = &IntegrationGithubAppResourceModel{}
+
+func (o *IntegrationGithubAppResourceModel) alias() string {
+	return o.Alias.ValueString()
+}
+
+func (o *IntegrationGithubAppResourceModel) ToApiModel() cortex.IntegrationConfiguration {
+	return cortex.IntegrationConfiguration{
+		"alias":        o.Alias.ValueString(),
+		"isDefault":    o.IsDefault.ValueBool(),
+		"appId":        o.AppId.ValueString(),
+		"clientId":     o.ClientId.ValueString(),
+		"clientSecret": o.ClientSecretWo.ValueString(),
+		"privateKey":   o.PrivateKeyWo.ValueString(),
+		"apiHost":      o.ApiHost.ValueString(),
+	}
+}
+
+func (o *IntegrationGithubAppResourceModel) FromApiModel(config cortex.IntegrationConfiguration) {
+	o.Id = types.StringValue(config.Alias())
+	o.Alias = types.StringValue(config.Alias())
+	o.IsDefault = types.BoolValue(config.IsDefault())
+	o.AppId = types.StringValue(config.String("appId"))
+	o.ClientId = types.StringValue(config.String("clientId"))
+	o.ApiHost = stringValueOrNull(config.String("apiHost"))
+}
+
+/***********************************************************************************************************************
+ * Schema
+ **********************************************************************************************************************/
+
+func (r *IntegrationGithubAppResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "GitHub App Integration. Configures a GitHub app installation, referenced by the `github` Git settings of catalog entities. Requires Terraform 1.11 or later, for write-only secrets.",
+
+		Attributes: integrationResourceAttributes("GitHub app", map[string]schema.Attribute{
+			"app_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the GitHub app.",
+				Required:            true,
+			},
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "Client ID of the GitHub app.",
+				Required:            true,
+			},
+			"client_secret_wo": schema.StringAttribute{
+				MarkdownDescription: "Client secret of the GitHub app. Write-only: it is sent to Cortex but never stored in state.",
+				Required:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"private_key_wo": schema.StringAttribute{
+				MarkdownDescription: "Private key of the GitHub app, in PEM format. Write-only: it is sent to Cortex but never stored in state.",
+				Required:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"api_host": schema.StringAttribute{
+				MarkdownDescription: "API host of a GitHub Enterprise Server instance, such as `github.example.com/api/v3`. If omitted, github.com is used.",
+				Optional:            true,
+			},
+		}),
+	}
+}
+
+/***********************************************************************************************************************
+ * Methods
+ **********************************************************************************************************************/
+
+func (r *IntegrationGithubAppResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration_github_app"
+}
+
+func (r *IntegrationGithubAppResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	data := IntegrationGithubAppResourceModel{}
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.read(ctx, &resp.Diagnostics, &data) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IntegrationGithubAppResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	data := IntegrationGithubAppResourceModel{}
+
+	// Read Terraform plan data into the model, and write-only secrets from the configuration
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("client_secret_wo"), &data.ClientSecretWo)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("private_key_wo"), &data.PrivateKeyWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.create(ctx, &resp.Diagnostics, &data)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IntegrationGithubAppResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	data := IntegrationGithubAppResourceModel{}
+
+	// Read Terraform plan data into the model, and write-only secrets from the configuration
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("client_secret_wo"), &data.ClientSecretWo)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("private_key_wo"), &data.PrivateKeyWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.update(ctx, &resp.Diagnostics, &data)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &IntegrationPagerDutyResource{}
+var _ resource.ResourceWithImportState = &IntegrationPagerDutyResource{}
+
+func NewIntegrationPagerDutyResource() resource.Resource {
+	return &IntegrationPagerDutyResource{
+		integrationResource: integrationResource{integration: cortex.IntegrationPagerDuty, name: "PagerDuty"},
+	}
+}
+
+/***********************************************************************************************************************
+ * Types
+ **********************************************************************************************************************/
+
+// IntegrationPagerDutyResource defines the resource implementation.
+type IntegrationPagerDutyResource struct {
+	integrationResource
+}
+
+// IntegrationPagerDutyResourceModel describes the PagerDuty configuration data model within Terraform.
+type IntegrationPagerDutyResourceModel struct {
+	Id               types.String `tfsdk:"id"`
+	Alias            types.String `tfsdk:"alias"`
+	IsDefault        types.Bool   `tfsdk:"is_default"`
+	SecretsWoVersion types.Int64  `tfsdk:"secrets_wo_version"`
+	TokenWo          types.String `tfsdk:"token_wo"`
+	IsTokenReadOnly  types.Bool   `tfsdk:"is_token_read_only"`
+}
+
+var _ integrationResourceModel = &IntegrationPagerDutyResourceModel{}
+
+func (o *IntegrationPagerDutyResourceModel) alias() string {
+	return o.Alias.ValueString()
+}
+
+func (o *IntegrationPagerDutyResourceModel) ToApiModel() cortex.IntegrationConfiguration {
+	return cortex.IntegrationConfiguration{
+		"alias":           o.Alias.ValueString(),
+		"isDefault":       o.IsDefault.ValueBool(),
+		"token":           o.TokenWo.ValueString(),
+		"isTokenReadonly": o.IsTokenReadOnly.ValueBool(),
+	}
+}
+
+func (o *IntegrationPagerDutyResourceModel) FromApiModel(config cortex.IntegrationConfiguration) {
+	o.Id = types.StringValue(config.Alias())
+	o.Alias = types.StringValue(config.Alias())
+	o.IsDefault = types.BoolValue(config.IsDefault())
+	o.IsTokenReadOnly = types.BoolValue(config.Bool("isTokenReadonly"))
+}
+
+/***********************************************************************************************************************
+ * Schema
+ **********************************************************************************************************************/
+
+func (r *IntegrationPagerDutyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "PagerDuty Integration. Configures a PagerDuty account, referenced by the `pagerduty` on-call settings of catalog entities. Requires Terraform 1.11 or later, for write-only secrets.",
+
+		Attributes: integrationResourceAttributes("PagerDuty", map[string]schema.Attribute{
+			"token_wo": schema.StringAttribute{
+				MarkdownDescription: "PagerDuty API token. Write-only: it is sent to Cortex but never stored in state.",
+				Required:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"is_token_read_only": schema.BoolAttribute{
+				MarkdownDescription: "Whether the token is read-only, which prevents Cortex from triggering incidents. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		}),
+	}
+}
+
+/***********************************************************************************************************************
+ * Methods
+ **********************************************************************************************************************/
+
+func (r *IntegrationPagerDutyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration_pagerduty"
+}
+
+func (r *IntegrationPagerDutyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	data := IntegrationPagerDutyResourceModel{}
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.read(ctx, &resp.Diagnostics, &data) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IntegrationPagerDutyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	data := IntegrationPagerDutyResourceModel{}
+
+	// Read Terraform plan data into the model, and write-only secrets from the configuration
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("token_wo"), &data.TokenWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.create(ctx, &resp.Diagnostics, &data)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IntegrationPagerDutyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	data := IntegrationPagerDutyResourceModel{}
+
+	// Read Terraform plan data into the model, and write-only secrets from the configuration
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("token_wo"), &data.TokenWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.update(ctx, &resp.Diagnostics, &data)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+/***********************************************************************************************************************
+ * Types
+ **********************************************************************************************************************/
+
+// integrationResource implements the parts of the integration configuration resources, such as
+// cortex_integration_pagerduty, that don't depend on the integration's fields.
+type integrationResource struct {
+	client *cortex.HttpClient
+
+	// integration is the configurations endpoint of the integration, such as cortex.IntegrationPagerDuty.
+	integration string
+	// name is the name of the integration used in error messages, such as "PagerDuty".
+	name string
+}
+
+// integrationResourceModel is implemented by the data models of the integration configuration resources. Secrets are
+// write-only, so FromApiModel leaves them untouched.
+type integrationResourceModel interface {
+	ToApiModel() cortex.IntegrationConfiguration
+	FromApiModel(config cortex.IntegrationConfiguration)
+	alias() string
+}
+
+/***********************************************************************************************************************
+ * Schema
+ **********************************************************************************************************************/
+
+// integrationResourceAttributes returns the attributes shared by every integration configuration resource, merged with
+// the integration's own attributes.
+func integrationResourceAttributes(name string, attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	attributes["alias"] = schema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("Alias of the %s configuration, referenced by catalog entities when more than one is configured. **Note:** Changing this attribute will force replacement of the resource.", name),
+		Required:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["is_default"] = schema.BoolAttribute{
+		MarkdownDescription: fmt.Sprintf("Whether this is the default %s configuration, used by catalog entities that don't specify an alias. Defaults to `false`.", name),
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+	}
+	attributes["secrets_wo_version"] = schema.Int64Attribute{
+		MarkdownDescription: "Secrets are write-only and never stored in state, so Terraform can't detect when they change. Increment this to send updated secrets to Cortex.",
+		Optional:            true,
+	}
+	attributes["id"] = schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	return attributes
+}
+
+/***********************************************************************************************************************
+ * Methods
+ **********************************************************************************************************************/
+
+func (r *integrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cortex.HttpClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// read refreshes data from the API, returning false if the configuration no longer exists.
+func (r *integrationResource) read(ctx context.Context, diagnostics *diag.Diagnostics, data integrationResourceModel) bool {
+	config, err := r.client.IntegrationConfigurations().Get(ctx, r.integration, data.alias())
+	if err != nil {
+		if errors.Is(err, cortex.ApiErrorNotFound) {
+			return false
+		}
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read %s configuration %s, got error: %s", r.name, data.alias(), err))
+		return true
+	}
+
+	data.FromApiModel(config)
+	return true
+}
+
+func (r *integrationResource) create(ctx context.Context, diagnostics *diag.Diagnostics, data integrationResourceModel) {
+	config, err := r.client.IntegrationConfigurations().Create(ctx, r.integration, data.ToApiModel())
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create %s configuration, got error: %s", r.name, err))
+		return
+	}
+
+	data.FromApiModel(config)
+}
+
+func (r *integrationResource) update(ctx context.Context, diagnostics *diag.Diagnostics, data integrationResourceModel) {
+	config, err := r.client.IntegrationConfigurations().Update(ctx, r.integration, data.alias(), data.ToApiModel())
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update %s configuration, got error: %s", r.name, err))
+		return
+	}
+
+	data.FromApiModel(config)
+}
+
+func (r *integrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var alias types.String
+
+	// Read Terraform prior state data
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("alias"), &alias)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.IntegrationConfigurations().Delete(ctx, r.integration, alias.ValueString())
+	if err != nil && !errors.Is(err, cortex.ApiErrorNotFound) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete %s configuration, got error: %s", r.name, err))
+		return
+	}
+}
+
+// ImportState imports a configuration by its alias. Secrets can't be imported, so the imported configuration keeps
+// its secrets until they are next updated.
+func (r *integrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("alias"), req, resp)
+}
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntegrationResourceModelsSendSecrets(t *testing.T) {
+	pagerDuty := IntegrationPagerDutyResourceModel{
+		Alias:           types.StringValue("production"),
+		IsDefault:       types.BoolValue(true),
+		TokenWo:         types.StringValue("pd-token"),
+		IsTokenReadOnly: types.BoolValue(false),
+	}
+	assert.Equal(t, "pd-token", pagerDuty.ToApiModel().String("token"))
+
+	datadog := IntegrationDatadogResourceModel{
+		Alias:    types.StringValue("production"),
+		ApiKeyWo: types.StringValue("dd-api-key"),
+		AppKeyWo: types.StringValue("dd-app-key"),
+		Region:   types.StringValue("EU1"),
+	}
+	config := datadog.ToApiModel()
+	assert.Equal(t, "dd-api-key", config.String("apiKey"))
+	assert.Equal(t, "dd-app-key", config.String("appKey"))
+	assert.Equal(t, "EU1", config.String("region"))
+
+	githubApp := IntegrationGithubAppResourceModel{
+		Alias:          types.StringValue("github"),
+		AppId:          types.StringValue("12345"),
+		ClientId:       types.StringValue("Iv1.abc"),
+		ClientSecretWo: types.StringValue("client-secret"),
+		PrivateKeyWo:   types.StringValue("private-key"),
+	}
+	config = githubApp.ToApiModel()
+	assert.Equal(t, "client-secret", config.String("clientSecret"))
+	assert.Equal(t, "private-key", config.String("privateKey"))
+}
+
+func TestIntegrationResourceModelsFromApiModel(t *testing.T) {
+	// The API never returns secrets, so the models must not expect them.
+	config := cortex.IntegrationConfiguration{
+		"alias":     "production",
+		"isDefault": true,
+		"region":    "US5",
+		"appId":     "12345",
+		"clientId":  "Iv1.abc",
+	}
+
+	datadog := IntegrationDatadogResourceModel{}
+	datadog.FromApiModel(config)
+	assert.Equal(t, "production", datadog.Id.ValueString())
+	assert.True(t, datadog.IsDefault.ValueBool())
+	assert.Equal(t, "US5", datadog.Region.ValueString())
+	assert.True(t, datadog.CustomSubdomain.IsNull())
+	assert.True(t, datadog.ApiKeyWo.IsNull())
+
+	githubApp := IntegrationGithubAppResourceModel{}
+	githubApp.FromApiModel(config)
+	assert.Equal(t, "12345", githubApp.AppId.ValueString())
+	assert.True(t, githubApp.ApiHost.IsNull())
+	assert.True(t, githubApp.PrivateKeyWo.IsNull())
+
+	pagerDuty := IntegrationPagerDutyResourceModel{}
+	pagerDuty.FromApiModel(config)
+	assert.False(t, pagerDuty.IsTokenReadOnly.ValueBool())
+}
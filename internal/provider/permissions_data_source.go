@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PermissionsDataSource{}
+
+func NewPermissionsDataSource() datasource.DataSource {
+	return &PermissionsDataSource{}
+}
+
+// PermissionsDataSource defines the data source implementation.
+type PermissionsDataSource struct {
+	client *cortex.HttpClient
+}
+
+// PermissionsDataSourceModel describes the data source data model.
+type PermissionsDataSourceModel struct {
+	Id          types.String                    `tfsdk:"id"`
+	Identifiers []types.String                  `tfsdk:"identifiers"`
+	Permissions []PermissionDataSourceItemModel `tfsdk:"permissions"`
+}
+
+// PermissionDataSourceItemModel is a single permission that can be granted by a role.
+type PermissionDataSourceItemModel struct {
+	Identifier  types.String `tfsdk:"identifier"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Category    types.String `tfsdk:"category"`
+}
+
+func (d *PermissionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permissions"
+}
+
+func (d *PermissionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Permissions data source - lists the permissions that can be granted by a `cortex_role`",
+
+		Attributes: map[string]schema.Attribute{
+			// Computed
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Internal identifier for this data source",
+				Computed:            true,
+			},
+			"identifiers": schema.ListAttribute{
+				MarkdownDescription: "Identifiers of every permission, for validating the `permissions` of a `cortex_role`",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"permissions": schema.ListNestedAttribute{
+				MarkdownDescription: "Every permission that can be granted",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"identifier": schema.StringAttribute{
+							MarkdownDescription: "Identifier of the permission, as used in the `permissions` of a `cortex_role`",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the permission",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the permission",
+							Computed:            true,
+						},
+						"category": schema.StringAttribute{
+							MarkdownDescription: "Category the permission is grouped under in the Cortex UI",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PermissionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cortex.HttpClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *PermissionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PermissionsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissionsResponse, err := d.client.Permissions().List(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read permissions, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue("permissions")
+	data.Identifiers = make([]types.String, len(permissionsResponse.Permissions))
+	data.Permissions = make([]PermissionDataSourceItemModel, len(permissionsResponse.Permissions))
+	for i, permission := range permissionsResponse.Permissions {
+		data.Identifiers[i] = types.StringValue(permission.Identifier)
+		data.Permissions[i] = PermissionDataSourceItemModel{
+			Identifier:  types.StringValue(permission.Identifier),
+			Name:        types.StringValue(permission.Name),
+			Description: stringValueOrNull(permission.Description),
+			Category:    stringValueOrNull(permission.Category),
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
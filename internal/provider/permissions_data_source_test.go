@@ -0,0 +1,33 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPermissionsDataSource(t *testing.T) {
+	recordName := "data.cortex_permissions.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing - list all permissions
+			{
+				Config: testAccPermissionsDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(recordName, "id"),
+					resource.TestCheckResourceAttrSet(recordName, "identifiers.#"),
+					resource.TestCheckResourceAttrSet(recordName, "permissions.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPermissionsDataSourceConfig() string {
+	return `
+data "cortex_permissions" "test" {
+}
+`
+}
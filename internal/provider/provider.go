@@ -5,16 +5,20 @@ import (
 	"fmt"
 	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"os"
+	"strconv"
 )
 
 // Ensure CortexProvider satisfies various provider interfaces.
 var _ provider.Provider = &CortexProvider{}
+var _ provider.ProviderWithEphemeralResources = &CortexProvider{}
 
 const DefaultBaseApiUrl = "https://api.getcortexapp.com"
 
@@ -28,8 +32,19 @@ type CortexProvider struct {
 
 // CortexProviderModel describes the provider data model.
 type CortexProviderModel struct {
-	BaseApiUrl types.String `tfsdk:"base_api_url"`
-	Token      types.String `tfsdk:"token"`
+	BaseApiUrl         types.String `tfsdk:"base_api_url"`
+	Token              types.String `tfsdk:"token"`
+	ProxyUrl           types.String `tfsdk:"proxy_url"`
+	CaCertFile         types.String `tfsdk:"ca_cert_file"`
+	CaCertPem          types.String `tfsdk:"ca_cert_pem"`
+	ClientCert         types.String `tfsdk:"client_cert"`
+	ClientKey          types.String `tfsdk:"client_key"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	TokenFile          types.String `tfsdk:"token_file"`
+	TokenCommand       types.String `tfsdk:"token_command"`
+	Profile            types.String `tfsdk:"profile"`
+	ConfigFile         types.String `tfsdk:"config_file"`
+	VerifyCredentials  types.Bool   `tfsdk:"verify_credentials"`
 }
 
 func (p *CortexProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -49,6 +64,51 @@ func (p *CortexProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"token_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file containing the API token. The file is re-read for every request, so rotated tokens are picked up. Can also be set with the `CORTEX_API_TOKEN_FILE` environment variable.",
+				Optional:            true,
+			},
+			"token_command": schema.StringAttribute{
+				MarkdownDescription: "Shell command whose output is used as the API token, e.g. a Vault or 1Password CLI invocation. Run once when the provider is first used. Can also be set with the `CORTEX_API_TOKEN_COMMAND` environment variable.",
+				Optional:            true,
+			},
+			"profile": schema.StringAttribute{
+				MarkdownDescription: "Name of the profile in the Cortex config file to read `api_key` and `base_url` from. Can also be set with the `CORTEX_PROFILE` environment variable. Defaults to `default`.",
+				Optional:            true,
+			},
+			"config_file": schema.StringAttribute{
+				MarkdownDescription: "Path to the Cortex config file shared with the Cortex CLI. Can also be set with the `CORTEX_CONFIG_FILE` environment variable. Defaults to `~/.cortex/config`.",
+				Optional:            true,
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "URL of an HTTP(S) proxy to send all Cortex API requests through. Can also be set with the `CORTEX_PROXY_URL` environment variable. Defaults to the standard `HTTPS_PROXY`/`NO_PROXY` environment variables.",
+				Optional:            true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a PEM-encoded CA bundle used to verify the Cortex API, in addition to the system roots. Can also be set with the `CORTEX_CA_CERT_FILE` environment variable.",
+				Optional:            true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM-encoded CA bundle used to verify the Cortex API, in addition to the system roots. Can also be set with the `CORTEX_CA_CERT_PEM` environment variable.",
+				Optional:            true,
+			},
+			"client_cert": schema.StringAttribute{
+				MarkdownDescription: "PEM-encoded client certificate presented to the Cortex API for mutual TLS. Requires `client_key`. Can also be set with the `CORTEX_CLIENT_CERT` environment variable.",
+				Optional:            true,
+			},
+			"client_key": schema.StringAttribute{
+				MarkdownDescription: "PEM-encoded private key for `client_cert`. Can also be set with the `CORTEX_CLIENT_KEY` environment variable.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skip verification of the Cortex API's TLS certificate. Only use this for development. Can also be set with the `CORTEX_INSECURE_SKIP_VERIFY` environment variable.",
+				Optional:            true,
+			},
+			"verify_credentials": schema.BoolAttribute{
+				MarkdownDescription: "Check connectivity and the API token against the Cortex API when the provider is configured, instead of surfacing errors from individual resources. Can also be set with the `CORTEX_VERIFY_CREDENTIALS` environment variable. Defaults to `false`.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -62,41 +122,111 @@ func (p *CortexProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
-	// Configuration values are now available.
-	baseApiUrl := DefaultBaseApiUrl
-	if data.BaseApiUrl.IsNull() || data.BaseApiUrl.ValueString() == "" {
-		envApiUrl := os.Getenv("CORTEX_API_URL")
-		if envApiUrl != "" {
-			baseApiUrl = envApiUrl
-		}
-	} else {
-		baseApiUrl = data.BaseApiUrl.ValueString()
-	}
-	if data.Token.IsUnknown() || data.Token.ValueString() == "" {
-		token := os.Getenv("CORTEX_API_TOKEN")
-		if token == "" {
-			resp.Diagnostics.AddAttributeError(path.Root("token"), "token is required", "Please specify an API token for the Cortex API")
+	// Credentials that reference resources are unknown until apply; defer if Terraform supports it, since falling back
+	// to the environment would silently use different credentials.
+	if unknown := unknownCredentialAttributes(data); len(unknown) > 0 {
+		if req.ClientCapabilities.DeferralAllowed {
+			resp.Deferred = &provider.Deferred{Reason: provider.DeferredReasonProviderConfigUnknown}
 			return
 		}
-		data.Token = types.StringValue(token)
+		for _, name := range unknown {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Unknown Cortex provider configuration value",
+				fmt.Sprintf("The provider cannot create the Cortex API client because %s is unknown during plan. Set it to a value that is known before apply, or leave it unset and configure it through the environment.", name),
+			)
+		}
+		return
 	}
 
-	// Creating a new GitLab Client from the provider configuration
-	client, err := cortex.NewClient(
-		cortex.WithContext(ctx),
-		cortex.WithURL(baseApiUrl),
-		cortex.WithToken(data.Token.ValueString()),
+	// Configuration values are now available.
+	creds, diags := resolveCredentials(data, os.Getenv)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Resolved Cortex API credentials", map[string]interface{}{
+		"base_api_url": creds.BaseApiUrl,
+		"token_source": creds.Source,
+	})
+
+	opts := []cortex.OptionDelegator{
+		cortex.WithURL(creds.BaseApiUrl),
+		cortex.WithTokenSource(creds.TokenSource),
 		cortex.WithVersion(p.version),
-	)
+	}
+
+	if proxyUrl := stringValueOrEnv(data.ProxyUrl, "CORTEX_PROXY_URL"); proxyUrl != "" {
+		opts = append(opts, cortex.WithProxyURL(proxyUrl))
+	}
+
+	insecureSkipVerify, err := boolValueOrEnv(data.InsecureSkipVerify, "CORTEX_INSECURE_SKIP_VERIFY")
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("insecure_skip_verify"), "Invalid insecure_skip_verify", err.Error())
+		return
+	}
+	tlsOptions := cortex.TLSOptions{
+		CACertFile:         stringValueOrEnv(data.CaCertFile, "CORTEX_CA_CERT_FILE"),
+		CACertPEM:          stringValueOrEnv(data.CaCertPem, "CORTEX_CA_CERT_PEM"),
+		ClientCertPEM:      stringValueOrEnv(data.ClientCert, "CORTEX_CLIENT_CERT"),
+		ClientKeyPEM:       stringValueOrEnv(data.ClientKey, "CORTEX_CLIENT_KEY"),
+		InsecureSkipVerify: insecureSkipVerify,
+	}
+	if tlsOptions.Enabled() {
+		tlsConfig, err := cortex.NewTLSConfig(tlsOptions)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid TLS configuration", fmt.Sprintf("The provider failed to build its TLS configuration: %+v", err))
+			return
+		}
+		opts = append(opts, cortex.WithTLSConfig(tlsConfig))
+	}
 
+	client, err := cortex.NewClient(opts...)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to create Cortex API Client from provider configuration", fmt.Sprintf("The provider failed to create a new Cortex API Client from the given configuration: %+v", err))
 		return
 	}
 
+	verify, err := boolValueOrEnv(data.VerifyCredentials, "CORTEX_VERIFY_CREDENTIALS")
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("verify_credentials"), "Invalid verify_credentials", err.Error())
+		return
+	}
+	if verify {
+		resp.Diagnostics.Append(verifyCredentials(ctx, client, creds)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Example client configuration for data sources and resources
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
+}
+
+// stringValueOrEnv returns the configured value, falling back to the given environment variable when it is not set.
+func stringValueOrEnv(value types.String, envKey string) string {
+	if value.IsNull() || value.IsUnknown() || value.ValueString() == "" {
+		return os.Getenv(envKey)
+	}
+	return value.ValueString()
+}
+
+// boolValueOrEnv returns the configured value, falling back to the given environment variable when it is not set.
+func boolValueOrEnv(value types.Bool, envKey string) (bool, error) {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueBool(), nil
+	}
+	env := os.Getenv(envKey)
+	if env == "" {
+		return false, nil
+	}
+	b, err := strconv.ParseBool(env)
+	if err != nil {
+		return false, fmt.Errorf("%s must be a boolean, got %q", envKey, env)
+	}
+	return b, nil
 }
 
 func (p *CortexProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -107,6 +237,25 @@ func (p *CortexProvider) Resources(ctx context.Context) []func() resource.Resour
 		NewResourceDefinitionResource,
 		NewCatalogEntityCustomDataResource,
 		NewCatalogEntityOpenAPIResource,
+		NewInitiativeResource,
+		NewScorecardRuleExemptionResource,
+		NewCatalogEntityDependencyResource,
+		NewRelationshipTypeResource,
+		NewEntityRelationshipResource,
+		NewCatalogEntityDeployResource,
+		NewCatalogEntityCustomEventResource,
+		NewCatalogEntityGroupMembershipResource,
+		NewWorkflowResource,
+		NewScaffolderTemplateResource,
+		NewIntegrationPagerDutyResource,
+		NewIntegrationDatadogResource,
+		NewIntegrationGithubAppResource,
+		NewEntityVerificationPeriodResource,
+		NewEntityTypeResource,
+		NewApiKeyResource,
+		NewRoleResource,
+		NewRoleAssignmentResource,
+		NewCustomMetricResource,
 	}
 }
 
@@ -120,6 +269,23 @@ func (p *CortexProvider) DataSources(ctx context.Context) []func() datasource.Da
 		NewScorecardDataSource,
 		NewResourceDefinitionDataSource,
 		NewCatalogEntityCustomDataDataSource,
+		NewInitiativeDataSource,
+		NewScorecardScoresDataSource,
+		NewCatalogEntityDeploysDataSource,
+		NewCatalogEntityGroupsDataSource,
+		NewScaffolderTemplatesDataSource,
+		NewEntityVerificationStatusesDataSource,
+		NewPermissionsDataSource,
+		NewUserDataSource,
+		NewUsersDataSource,
+		NewAuditLogsDataSource,
+		NewCustomMetricValuesDataSource,
+	}
+}
+
+func (p *CortexProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewApiKeyEphemeralResource,
 	}
 }
 
@@ -0,0 +1,218 @@
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+/***********************************************************************************************************************
+ * Credentials
+ **********************************************************************************************************************/
+
+// providerCredentials is the resolved API URL and token source for the Cortex client.
+type providerCredentials struct {
+	BaseApiUrl  string
+	TokenSource cortex.TokenSource
+	// Source describes where the token came from, e.g. "token_file" or "profile default", for diagnostics.
+	Source string
+}
+
+// configuredString returns the value of a provider attribute, or "" if it is null or unknown.
+func configuredString(value types.String) string {
+	if value.IsNull() || value.IsUnknown() {
+		return ""
+	}
+	return value.ValueString()
+}
+
+// resolveCredentials picks the API token source in the following order, first match wins:
+//
+//  1. token
+//  2. token_file
+//  3. token_command
+//  4. CORTEX_API_TOKEN
+//  5. CORTEX_API_TOKEN_FILE
+//  6. CORTEX_API_TOKEN_COMMAND
+//  7. api_key of the profile (profile / CORTEX_PROFILE, default "default") in the config file
+//     (config_file / CORTEX_CONFIG_FILE, default ~/.cortex/config)
+//
+// The base URL is taken from base_api_url, then CORTEX_API_URL, then the profile's base_url, then DefaultBaseApiUrl.
+// A profile is only read when it is explicitly selected or when no other token source is configured.
+func resolveCredentials(data CortexProviderModel, getenv func(string) string) (providerCredentials, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	creds := providerCredentials{}
+
+	switch {
+	case configuredString(data.Token) != "":
+		creds.TokenSource, creds.Source = cortex.StaticTokenSource(data.Token.ValueString()), "token"
+	case configuredString(data.TokenFile) != "":
+		creds.TokenSource, creds.Source = &cortex.FileTokenSource{Path: data.TokenFile.ValueString()}, "token_file"
+	case configuredString(data.TokenCommand) != "":
+		creds.TokenSource, creds.Source = &cortex.CommandTokenSource{Command: data.TokenCommand.ValueString()}, "token_command"
+	case getenv("CORTEX_API_TOKEN") != "":
+		creds.TokenSource, creds.Source = cortex.StaticTokenSource(getenv("CORTEX_API_TOKEN")), "CORTEX_API_TOKEN"
+	case getenv("CORTEX_API_TOKEN_FILE") != "":
+		creds.TokenSource, creds.Source = &cortex.FileTokenSource{Path: getenv("CORTEX_API_TOKEN_FILE")}, "CORTEX_API_TOKEN_FILE"
+	case getenv("CORTEX_API_TOKEN_COMMAND") != "":
+		creds.TokenSource, creds.Source = &cortex.CommandTokenSource{Command: getenv("CORTEX_API_TOKEN_COMMAND")}, "CORTEX_API_TOKEN_COMMAND"
+	}
+
+	profileName := configuredString(data.Profile)
+	if profileName == "" {
+		profileName = getenv("CORTEX_PROFILE")
+	}
+	explicitProfile := profileName != ""
+	if !explicitProfile {
+		profileName = cortex.DefaultProfile
+	}
+
+	var profile *cortex.Profile
+	if explicitProfile || creds.TokenSource == nil {
+		configFile := configuredString(data.ConfigFile)
+		if configFile == "" {
+			configFile = getenv("CORTEX_CONFIG_FILE")
+		}
+		if configFile == "" {
+			var err error
+			configFile, err = cortex.DefaultConfigFile()
+			if err != nil && explicitProfile {
+				diags.AddAttributeError(path.Root("config_file"), "Unable to locate Cortex config file", err.Error())
+				return creds, diags
+			}
+		}
+		p, err := cortex.LoadProfile(configFile, profileName)
+		if err == nil {
+			profile = &p
+		} else if explicitProfile {
+			diags.AddAttributeError(path.Root("profile"), "Unable to load Cortex profile", err.Error())
+			return creds, diags
+		}
+	}
+
+	if creds.TokenSource == nil && profile != nil && profile.ApiKey != "" {
+		creds.TokenSource, creds.Source = cortex.StaticTokenSource(profile.ApiKey), fmt.Sprintf("profile %s", profile.Name)
+	}
+	if creds.TokenSource == nil {
+		diags.AddAttributeError(
+			path.Root("token"),
+			"token is required",
+			"Please specify an API token for the Cortex API with token, token_file, token_command, the CORTEX_API_TOKEN environment variable, or a profile in the Cortex config file.",
+		)
+		return creds, diags
+	}
+
+	switch {
+	case configuredString(data.BaseApiUrl) != "":
+		creds.BaseApiUrl = data.BaseApiUrl.ValueString()
+	case getenv("CORTEX_API_URL") != "":
+		creds.BaseApiUrl = getenv("CORTEX_API_URL")
+	case profile != nil && profile.BaseUrl != "":
+		creds.BaseApiUrl = profile.BaseUrl
+	default:
+		creds.BaseApiUrl = DefaultBaseApiUrl
+	}
+
+	return creds, diags
+}
+
+// unknownCredentialAttributes returns the credential and connection attributes whose values are not known yet, e.g.
+// because they reference a resource that has not been created during plan.
+func unknownCredentialAttributes(data CortexProviderModel) []string {
+	attributes := map[string]types.String{
+		"base_api_url":  data.BaseApiUrl,
+		"token":         data.Token,
+		"token_file":    data.TokenFile,
+		"token_command": data.TokenCommand,
+		"profile":       data.Profile,
+		"config_file":   data.ConfigFile,
+		"proxy_url":     data.ProxyUrl,
+		"ca_cert_file":  data.CaCertFile,
+		"ca_cert_pem":   data.CaCertPem,
+		"client_cert":   data.ClientCert,
+		"client_key":    data.ClientKey,
+	}
+	var unknown []string
+	for name, value := range attributes {
+		if value.IsUnknown() {
+			unknown = append(unknown, name)
+		}
+	}
+	if data.InsecureSkipVerify.IsUnknown() {
+		unknown = append(unknown, "insecure_skip_verify")
+	}
+	sort.Strings(unknown)
+	return unknown
+}
+
+/***********************************************************************************************************************
+ * Verification
+ **********************************************************************************************************************/
+
+// verifyCredentials pings the Cortex API and reports any failure as a single provider diagnostic that distinguishes
+// an unreachable host, a TLS failure, and a rejected (401) or under-privileged (403) token.
+func verifyCredentials(ctx context.Context, client *cortex.HttpClient, creds providerCredentials) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	err := client.Ping(ctx)
+	if err == nil {
+		return diags
+	}
+
+	var certVerificationErr *tls.CertificateVerificationError
+	var unknownAuthorityErr x509.UnknownAuthorityError
+	var hostnameErr x509.HostnameError
+	var certInvalidErr x509.CertificateInvalidError
+	var alertErr tls.AlertError
+	var recordHeaderErr tls.RecordHeaderError
+	var dnsErr *net.DNSError
+	var opErr *net.OpError
+
+	switch {
+	case errors.Is(err, cortex.ApiErrorUnauthorized):
+		diags.AddAttributeError(
+			path.Root("token"),
+			"Invalid Cortex API token",
+			fmt.Sprintf("The Cortex API at %s rejected the API token from %s (401 Unauthorized). Check that the token is correct and has not expired or been revoked.", creds.BaseApiUrl, creds.Source),
+		)
+	case errors.Is(err, cortex.ApiErrorForbidden):
+		diags.AddAttributeError(
+			path.Root("token"),
+			"Insufficient Cortex API permissions",
+			fmt.Sprintf("The Cortex API at %s accepted the API token from %s but denied access (403 Forbidden). Check the roles assigned to the token.", creds.BaseApiUrl, creds.Source),
+		)
+	case errors.As(err, &certVerificationErr),
+		errors.As(err, &unknownAuthorityErr),
+		errors.As(err, &hostnameErr),
+		errors.As(err, &certInvalidErr),
+		errors.As(err, &alertErr),
+		errors.As(err, &recordHeaderErr):
+		diags.AddAttributeError(
+			path.Root("base_api_url"),
+			"Cortex API TLS handshake failed",
+			fmt.Sprintf("Could not establish a trusted TLS connection to %s: %s\n\nIf the API uses a certificate from an internal CA, set ca_cert_file or ca_cert_pem. If it requires mutual TLS, set client_cert and client_key.", creds.BaseApiUrl, err),
+		)
+	case errors.As(err, &dnsErr), errors.As(err, &opErr):
+		diags.AddAttributeError(
+			path.Root("base_api_url"),
+			"Unable to reach the Cortex API",
+			fmt.Sprintf("Could not connect to %s: %s\n\nCheck base_api_url (or CORTEX_API_URL) and any proxy settings.", creds.BaseApiUrl, err),
+		)
+	default:
+		diags.AddError(
+			"Unable to verify Cortex API credentials",
+			fmt.Sprintf("Verifying the API token against %s failed: %s", creds.BaseApiUrl, err),
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,326 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func writeTestConfigFile(t *testing.T) string {
+	configFile := filepath.Join(t.TempDir(), "config")
+	err := os.WriteFile(configFile, []byte(`[default]
+api_key = default-profile-token
+base_url = https://cortex.default.internal
+
+[staging]
+api_key = staging-profile-token
+base_url = https://cortex.staging.internal
+`), 0600)
+	assert.Nil(t, err)
+	return configFile
+}
+
+func newTestProviderModel() CortexProviderModel {
+	return CortexProviderModel{
+		BaseApiUrl:   types.StringNull(),
+		Token:        types.StringNull(),
+		TokenFile:    types.StringNull(),
+		TokenCommand: types.StringNull(),
+		Profile:      types.StringNull(),
+		ConfigFile:   types.StringNull(),
+	}
+}
+
+func TestResolveCredentials(t *testing.T) {
+	configFile := writeTestConfigFile(t)
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	assert.Nil(t, os.WriteFile(tokenFile, []byte("file-token\n"), 0600))
+	missingConfigFile := filepath.Join(t.TempDir(), "missing")
+
+	tests := []struct {
+		name           string
+		model          func(m *CortexProviderModel)
+		env            map[string]string
+		expectedToken  string
+		expectedSource string
+		expectedUrl    string
+	}{
+		{
+			name: "token attribute wins over everything",
+			model: func(m *CortexProviderModel) {
+				m.Token = types.StringValue("attr-token")
+				m.TokenFile = types.StringValue(tokenFile)
+			},
+			env:            map[string]string{"CORTEX_API_TOKEN": "env-token", "CORTEX_CONFIG_FILE": configFile},
+			expectedToken:  "attr-token",
+			expectedSource: "token",
+			expectedUrl:    DefaultBaseApiUrl,
+		},
+		{
+			name: "token_file attribute",
+			model: func(m *CortexProviderModel) {
+				m.TokenFile = types.StringValue(tokenFile)
+				m.TokenCommand = types.StringValue("echo command-token")
+			},
+			env:            map[string]string{"CORTEX_API_TOKEN": "env-token"},
+			expectedToken:  "file-token",
+			expectedSource: "token_file",
+			expectedUrl:    DefaultBaseApiUrl,
+		},
+		{
+			name: "token_command attribute",
+			model: func(m *CortexProviderModel) {
+				m.TokenCommand = types.StringValue("echo command-token")
+			},
+			env:            map[string]string{"CORTEX_API_TOKEN": "env-token"},
+			expectedToken:  "command-token",
+			expectedSource: "token_command",
+			expectedUrl:    DefaultBaseApiUrl,
+		},
+		{
+			name:           "CORTEX_API_TOKEN",
+			env:            map[string]string{"CORTEX_API_TOKEN": "env-token", "CORTEX_API_TOKEN_FILE": tokenFile, "CORTEX_CONFIG_FILE": configFile},
+			expectedToken:  "env-token",
+			expectedSource: "CORTEX_API_TOKEN",
+			expectedUrl:    DefaultBaseApiUrl,
+		},
+		{
+			name:           "CORTEX_API_TOKEN_FILE",
+			env:            map[string]string{"CORTEX_API_TOKEN_FILE": tokenFile, "CORTEX_API_TOKEN_COMMAND": "echo command-token"},
+			expectedToken:  "file-token",
+			expectedSource: "CORTEX_API_TOKEN_FILE",
+			expectedUrl:    DefaultBaseApiUrl,
+		},
+		{
+			name:           "CORTEX_API_TOKEN_COMMAND",
+			env:            map[string]string{"CORTEX_API_TOKEN_COMMAND": "echo command-token"},
+			expectedToken:  "command-token",
+			expectedSource: "CORTEX_API_TOKEN_COMMAND",
+			expectedUrl:    DefaultBaseApiUrl,
+		},
+		{
+			name:           "default profile when nothing else is configured",
+			env:            map[string]string{"CORTEX_CONFIG_FILE": configFile},
+			expectedToken:  "default-profile-token",
+			expectedSource: "profile default",
+			expectedUrl:    "https://cortex.default.internal",
+		},
+		{
+			name: "named profile from attributes",
+			model: func(m *CortexProviderModel) {
+				m.Profile = types.StringValue("staging")
+				m.ConfigFile = types.StringValue(configFile)
+			},
+			expectedToken:  "staging-profile-token",
+			expectedSource: "profile staging",
+			expectedUrl:    "https://cortex.staging.internal",
+		},
+		{
+			name:           "named profile from environment",
+			env:            map[string]string{"CORTEX_PROFILE": "staging", "CORTEX_CONFIG_FILE": configFile},
+			expectedToken:  "staging-profile-token",
+			expectedSource: "profile staging",
+			expectedUrl:    "https://cortex.staging.internal",
+		},
+		{
+			name: "explicit profile supplies base url for an explicit token",
+			model: func(m *CortexProviderModel) {
+				m.Token = types.StringValue("attr-token")
+				m.Profile = types.StringValue("staging")
+			},
+			env:            map[string]string{"CORTEX_CONFIG_FILE": configFile},
+			expectedToken:  "attr-token",
+			expectedSource: "token",
+			expectedUrl:    "https://cortex.staging.internal",
+		},
+		{
+			name: "base_api_url wins over environment and profile",
+			model: func(m *CortexProviderModel) {
+				m.BaseApiUrl = types.StringValue("https://cortex.attr.internal")
+				m.Profile = types.StringValue("staging")
+			},
+			env:            map[string]string{"CORTEX_API_URL": "https://cortex.env.internal", "CORTEX_CONFIG_FILE": configFile},
+			expectedToken:  "staging-profile-token",
+			expectedSource: "profile staging",
+			expectedUrl:    "https://cortex.attr.internal",
+		},
+		{
+			name:           "CORTEX_API_URL wins over profile",
+			env:            map[string]string{"CORTEX_API_URL": "https://cortex.env.internal", "CORTEX_CONFIG_FILE": configFile},
+			expectedToken:  "default-profile-token",
+			expectedSource: "profile default",
+			expectedUrl:    "https://cortex.env.internal",
+		},
+		{
+			name:           "missing default config file is ignored when a token is set",
+			env:            map[string]string{"CORTEX_API_TOKEN": "env-token", "CORTEX_CONFIG_FILE": missingConfigFile},
+			expectedToken:  "env-token",
+			expectedSource: "CORTEX_API_TOKEN",
+			expectedUrl:    DefaultBaseApiUrl,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := newTestProviderModel()
+			if tt.model != nil {
+				tt.model(&model)
+			}
+			getenv := func(key string) string { return tt.env[key] }
+
+			creds, diags := resolveCredentials(model, getenv)
+			assert.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+
+			token, err := creds.TokenSource.Token(context.Background())
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expectedToken, token)
+			assert.Equal(t, tt.expectedSource, creds.Source)
+			assert.Equal(t, tt.expectedUrl, creds.BaseApiUrl)
+		})
+	}
+}
+
+func TestResolveCredentialsErrors(t *testing.T) {
+	configFile := writeTestConfigFile(t)
+	missingConfigFile := filepath.Join(t.TempDir(), "missing")
+
+	tests := []struct {
+		name     string
+		model    func(m *CortexProviderModel)
+		env      map[string]string
+		expected string
+	}{
+		{
+			name:     "no credentials",
+			env:      map[string]string{"CORTEX_CONFIG_FILE": missingConfigFile},
+			expected: "token is required",
+		},
+		{
+			name: "unknown profile",
+			model: func(m *CortexProviderModel) {
+				m.Profile = types.StringValue("production")
+			},
+			env:      map[string]string{"CORTEX_CONFIG_FILE": configFile},
+			expected: "Unable to load Cortex profile",
+		},
+		{
+			name:     "explicit profile without config file",
+			env:      map[string]string{"CORTEX_PROFILE": "staging", "CORTEX_CONFIG_FILE": missingConfigFile},
+			expected: "Unable to load Cortex profile",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := newTestProviderModel()
+			if tt.model != nil {
+				tt.model(&model)
+			}
+			getenv := func(key string) string { return tt.env[key] }
+
+			_, diags := resolveCredentials(model, getenv)
+			assert.True(t, diags.HasError())
+			assert.Equal(t, tt.expected, diags.Errors()[0].Summary())
+		})
+	}
+}
+
+func TestUnknownCredentialAttributes(t *testing.T) {
+	data := newTestProviderModel()
+	assert.Empty(t, unknownCredentialAttributes(data))
+
+	data.Token = types.StringUnknown()
+	data.BaseApiUrl = types.StringUnknown()
+	assert.Equal(t, []string{"base_api_url", "token"}, unknownCredentialAttributes(data))
+
+	data = newTestProviderModel()
+	data.ProxyUrl = types.StringUnknown()
+	data.CaCertPem = types.StringUnknown()
+	data.InsecureSkipVerify = types.BoolUnknown()
+	assert.Equal(t, []string{"ca_cert_pem", "insecure_skip_verify", "proxy_url"}, unknownCredentialAttributes(data))
+}
+
+func TestConfigureDefersUnknownProxyUrl(t *testing.T) {
+	ctx := context.Background()
+	p := &CortexProvider{version: "test"}
+	schemaResp := provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	values["token"] = tftypes.NewValue(tftypes.String, "test-token")
+	values["proxy_url"] = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+
+	req := provider.ConfigureRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(objectType, values),
+		},
+		ClientCapabilities: provider.ConfigureProviderClientCapabilities{DeferralAllowed: true},
+	}
+	resp := provider.ConfigureResponse{}
+	p.Configure(ctx, req, &resp)
+
+	assert.False(t, resp.Diagnostics.HasError())
+	assert.NotNil(t, resp.Deferred)
+	assert.Nil(t, resp.ResourceData, "the client must not be configured without the proxy")
+}
+
+func TestVerifyCredentials(t *testing.T) {
+	statusServer := func(status int) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(`{}`))
+		}))
+	}
+
+	okServer := statusServer(http.StatusOK)
+	defer okServer.Close()
+	unauthorizedServer := statusServer(http.StatusUnauthorized)
+	defer unauthorizedServer.Close()
+	forbiddenServer := statusServer(http.StatusForbidden)
+	defer forbiddenServer.Close()
+	tlsServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer tlsServer.Close()
+	closedServer := statusServer(http.StatusOK)
+	closedServer.Close()
+
+	tests := []struct {
+		name    string
+		url     string
+		summary string
+	}{
+		{name: "valid", url: okServer.URL},
+		{name: "unauthorized", url: unauthorizedServer.URL, summary: "Invalid Cortex API token"},
+		{name: "forbidden", url: forbiddenServer.URL, summary: "Insufficient Cortex API permissions"},
+		{name: "untrusted certificate", url: tlsServer.URL, summary: "Cortex API TLS handshake failed"},
+		{name: "unreachable", url: closedServer.URL, summary: "Unable to reach the Cortex API"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := cortex.NewClient(cortex.WithURL(tt.url), cortex.WithToken("test-token"))
+			assert.Nil(t, err)
+
+			diags := verifyCredentials(context.Background(), client, providerCredentials{BaseApiUrl: tt.url, Source: "token"})
+			if tt.summary == "" {
+				assert.False(t, diags.HasError())
+				return
+			}
+			assert.Equal(t, 1, diags.ErrorsCount())
+			assert.Equal(t, tt.summary, diags.Errors()[0].Summary())
+		})
+	}
+}
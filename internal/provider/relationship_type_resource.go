@@ -0,0 +1,263 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RelationshipTypeResource{}
+var _ resource.ResourceWithImportState = &RelationshipTypeResource{}
+
+func NewRelationshipTypeResource() resource.Resource {
+	return &RelationshipTypeResource{}
+}
+
+/***********************************************************************************************************************
+ * Types
+ **********************************************************************************************************************/
+
+// RelationshipTypeResource defines the resource implementation.
+type RelationshipTypeResource struct {
+	client *cortex.HttpClient
+}
+
+/***********************************************************************************************************************
+ * Schema
+ **********************************************************************************************************************/
+
+func (r *RelationshipTypeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Relationship Type. Defines a custom kind of directed relationship between catalog entities, such as `runs-on`, beyond parents and children.",
+
+		Attributes: map[string]schema.Attribute{
+			// Required attributes
+			"tag": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier for the relationship type.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the relationship type.",
+				Required:            true,
+			},
+
+			// Optional attributes
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the relationship type.",
+				Optional:            true,
+			},
+			"definition_location": schema.StringAttribute{
+				MarkdownDescription: "Which entity's definition declares the relationship: `SOURCE`, `DESTINATION` or `BOTH`. Defaults to `SOURCE`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(cortex.RelationshipTypeDefinitionLocationSource),
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						cortex.RelationshipTypeDefinitionLocationSource,
+						cortex.RelationshipTypeDefinitionLocationDestination,
+						cortex.RelationshipTypeDefinitionLocationBoth,
+					),
+				},
+			},
+			"cardinality": schema.StringAttribute{
+				MarkdownDescription: "Cardinality from sources to destinations: `ONE_TO_ONE`, `ONE_TO_MANY` (each destination has a single source), `MANY_TO_ONE` (each source has a single destination) or `MANY_TO_MANY`. Defaults to `MANY_TO_MANY`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(relationshipTypeCardinalityManyToMany),
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						relationshipTypeCardinalityOneToOne,
+						relationshipTypeCardinalityOneToMany,
+						relationshipTypeCardinalityManyToOne,
+						relationshipTypeCardinalityManyToMany,
+					),
+				},
+			},
+			"allow_cycles": schema.BoolAttribute{
+				MarkdownDescription: "Whether relationships of this type may form cycles. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"source_types": schema.SetAttribute{
+				MarkdownDescription: "Entity types allowed as the source of the relationship. If omitted, every entity type is allowed.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"destination_types": schema.SetAttribute{
+				MarkdownDescription: "Entity types allowed as the destination of the relationship. If omitted, every entity type is allowed.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"inheritances": schema.SetNestedAttribute{
+				MarkdownDescription: "Fields, such as owners, that destinations inherit from their sources.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"tag": schema.StringAttribute{
+							MarkdownDescription: "Field to inherit, such as `owners`.",
+							Required:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "How the field is inherited: `NONE`, `APPEND` (added to the destination's own values) or `FALLBACK` (used only if the destination has no values of its own).",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(
+									cortex.RelationshipTypeInheritanceNone,
+									cortex.RelationshipTypeInheritanceAppend,
+									cortex.RelationshipTypeInheritanceFallback,
+								),
+							},
+						},
+					},
+				},
+			},
+
+			// Computed attributes
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+/***********************************************************************************************************************
+ * Methods
+ **********************************************************************************************************************/
+
+func (r *RelationshipTypeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_relationship_type"
+}
+
+func (r *RelationshipTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cortex.HttpClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RelationshipTypeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	data := NewRelationshipTypeResourceModel()
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Issue API request
+	entity, err := r.client.RelationshipTypes().Get(ctx, data.Id.ValueString())
+	if err != nil {
+		if errors.Is(err, cortex.ApiErrorNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read relationship type %s, got error: %s", data.Id.ValueString(), err))
+		return
+	}
+
+	// Map data from the API response to the model
+	data.FromApiModel(entity)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RelationshipTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	data := NewRelationshipTypeResourceModel()
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientEntity := data.ToApiModel()
+	entity, err := r.client.RelationshipTypes().Create(ctx, clientEntity.ToUpsertRequest())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create relationship type, got error: %s", err))
+		return
+	}
+
+	data.FromApiModel(entity)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RelationshipTypeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	data := NewRelationshipTypeResourceModel()
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientEntity := data.ToApiModel()
+	entity, err := r.client.RelationshipTypes().Update(ctx, data.Tag.ValueString(), clientEntity.ToUpsertRequest())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update relationship type, got error: %s", err))
+		return
+	}
+
+	data.FromApiModel(entity)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RelationshipTypeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	data := NewRelationshipTypeResourceModel()
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RelationshipTypes().Delete(ctx, data.Tag.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete relationship type, got error: %s", err))
+		return
+	}
+}
+
+func (r *RelationshipTypeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
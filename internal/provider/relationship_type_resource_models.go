@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	relationshipTypeCardinalityOneToOne   = "ONE_TO_ONE"
+	relationshipTypeCardinalityOneToMany  = "ONE_TO_MANY"
+	relationshipTypeCardinalityManyToOne  = "MANY_TO_ONE"
+	relationshipTypeCardinalityManyToMany = "MANY_TO_MANY"
+)
+
+/***********************************************************************************************************************
+ * Models
+ **********************************************************************************************************************/
+
+// RelationshipTypeResourceModel describes the relationship type data model within Terraform.
+type RelationshipTypeResourceModel struct {
+	Id                 types.String                               `tfsdk:"id"`
+	Tag                types.String                               `tfsdk:"tag"`
+	Name               types.String                               `tfsdk:"name"`
+	Description        types.String                               `tfsdk:"description"`
+	DefinitionLocation types.String                               `tfsdk:"definition_location"`
+	Cardinality        types.String                               `tfsdk:"cardinality"`
+	AllowCycles        types.Bool                                 `tfsdk:"allow_cycles"`
+	SourceTypes        []types.String                             `tfsdk:"source_types"`
+	DestinationTypes   []types.String                             `tfsdk:"destination_types"`
+	Inheritances       []RelationshipTypeInheritanceResourceModel `tfsdk:"inheritances"`
+}
+
+type RelationshipTypeInheritanceResourceModel struct {
+	Tag  types.String `tfsdk:"tag"`
+	Type types.String `tfsdk:"type"`
+}
+
+func NewRelationshipTypeResourceModel() RelationshipTypeResourceModel {
+	return RelationshipTypeResourceModel{}
+}
+
+func (o *RelationshipTypeResourceModel) ToApiModel() cortex.RelationshipType {
+	cardinality := o.Cardinality.ValueString()
+	entity := cortex.RelationshipType{
+		Tag:                 o.Tag.ValueString(),
+		Name:                o.Name.ValueString(),
+		Description:         o.Description.ValueString(),
+		DefinitionLocation:  o.DefinitionLocation.ValueString(),
+		AllowCycles:         o.AllowCycles.ValueBool(),
+		IsSingleSource:      cardinality == relationshipTypeCardinalityOneToOne || cardinality == relationshipTypeCardinalityOneToMany,
+		IsSingleDestination: cardinality == relationshipTypeCardinalityOneToOne || cardinality == relationshipTypeCardinalityManyToOne,
+		SourcesFilter:       cortex.RelationshipTypeEntityFilter{Types: make([]string, len(o.SourceTypes))},
+		DestinationsFilter:  cortex.RelationshipTypeEntityFilter{Types: make([]string, len(o.DestinationTypes))},
+		Inheritances:        make([]cortex.RelationshipTypeInheritance, len(o.Inheritances)),
+	}
+	for i, t := range o.SourceTypes {
+		entity.SourcesFilter.Types[i] = t.ValueString()
+	}
+	for i, t := range o.DestinationTypes {
+		entity.DestinationsFilter.Types[i] = t.ValueString()
+	}
+	for i, inheritance := range o.Inheritances {
+		entity.Inheritances[i] = cortex.RelationshipTypeInheritance{
+			Tag:             inheritance.Tag.ValueString(),
+			InheritanceType: inheritance.Type.ValueString(),
+		}
+	}
+	return entity
+}
+
+func (o *RelationshipTypeResourceModel) FromApiModel(entity cortex.RelationshipType) {
+	o.Id = types.StringValue(entity.Tag)
+	o.Tag = types.StringValue(entity.Tag)
+	o.Name = types.StringValue(entity.Name)
+	if entity.Description != "" {
+		o.Description = types.StringValue(entity.Description)
+	} else {
+		o.Description = types.StringNull()
+	}
+	if entity.DefinitionLocation != "" {
+		o.DefinitionLocation = types.StringValue(entity.DefinitionLocation)
+	} else {
+		o.DefinitionLocation = types.StringValue(cortex.RelationshipTypeDefinitionLocationSource)
+	}
+	o.AllowCycles = types.BoolValue(entity.AllowCycles)
+
+	switch {
+	case entity.IsSingleSource && entity.IsSingleDestination:
+		o.Cardinality = types.StringValue(relationshipTypeCardinalityOneToOne)
+	case entity.IsSingleSource:
+		o.Cardinality = types.StringValue(relationshipTypeCardinalityOneToMany)
+	case entity.IsSingleDestination:
+		o.Cardinality = types.StringValue(relationshipTypeCardinalityManyToOne)
+	default:
+		o.Cardinality = types.StringValue(relationshipTypeCardinalityManyToMany)
+	}
+
+	o.SourceTypes = nil
+	for _, t := range entity.SourcesFilter.Types {
+		o.SourceTypes = append(o.SourceTypes, types.StringValue(t))
+	}
+	o.DestinationTypes = nil
+	for _, t := range entity.DestinationsFilter.Types {
+		o.DestinationTypes = append(o.DestinationTypes, types.StringValue(t))
+	}
+	o.Inheritances = nil
+	for _, inheritance := range entity.Inheritances {
+		o.Inheritances = append(o.Inheritances, RelationshipTypeInheritanceResourceModel{
+			Tag:  types.StringValue(inheritance.Tag),
+			Type: types.StringValue(inheritance.InheritanceType),
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRelationshipTypeResourceModelCardinality(t *testing.T) {
+	tests := []struct {
+		cardinality         string
+		isSingleSource      bool
+		isSingleDestination bool
+	}{
+		{cardinality: relationshipTypeCardinalityOneToOne, isSingleSource: true, isSingleDestination: true},
+		{cardinality: relationshipTypeCardinalityOneToMany, isSingleSource: true},
+		{cardinality: relationshipTypeCardinalityManyToOne, isSingleDestination: true},
+		{cardinality: relationshipTypeCardinalityManyToMany},
+	}
+	for _, tt := range tests {
+		t.Run(tt.cardinality, func(t *testing.T) {
+			model := NewRelationshipTypeResourceModel()
+			model.Tag = types.StringValue("runs-on")
+			model.Cardinality = types.StringValue(tt.cardinality)
+
+			entity := model.ToApiModel()
+			assert.Equal(t, tt.isSingleSource, entity.IsSingleSource)
+			assert.Equal(t, tt.isSingleDestination, entity.IsSingleDestination)
+
+			roundTrip := NewRelationshipTypeResourceModel()
+			roundTrip.FromApiModel(entity)
+			assert.Equal(t, tt.cardinality, roundTrip.Cardinality.ValueString())
+			assert.Nil(t, roundTrip.SourceTypes)
+			assert.Nil(t, roundTrip.Inheritances)
+		})
+	}
+}
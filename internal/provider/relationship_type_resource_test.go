@@ -0,0 +1,96 @@
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+type testRelationshipTypeResource struct {
+	Tag         string
+	Name        string
+	Cardinality string
+}
+
+func (t *testRelationshipTypeResource) ResourceFullName() string {
+	return t.ResourceType() + "." + t.Tag
+}
+
+func (t *testRelationshipTypeResource) ResourceType() string {
+	return "cortex_relationship_type"
+}
+
+func (t *testRelationshipTypeResource) ToTerraform() string {
+	return fmt.Sprintf(`
+resource %[1]q %[2]q {
+  tag               = %[2]q
+  name              = %[3]q
+  cardinality       = %[4]q
+  source_types      = ["service"]
+  destination_types = ["service"]
+  inheritances = [
+    {
+      tag  = "owners"
+      type = "APPEND"
+    }
+  ]
+}
+
+resource "cortex_entity_relationship" "test" {
+  relationship_type = %[1]s.%[2]s.tag
+  source_tag        = "manual-test"
+  destination_tag   = "manual-test-service"
+}`, t.ResourceType(), t.Tag, t.Name, t.Cardinality)
+}
+
+func TestAccRelationshipTypeResource(t *testing.T) {
+	stub := testRelationshipTypeResource{
+		Tag:         "terraform-test-runs-on",
+		Name:        "Runs on",
+		Cardinality: "MANY_TO_ONE",
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: stub.ToTerraform(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "id", stub.Tag),
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "name", stub.Name),
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "cardinality", stub.Cardinality),
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "definition_location", "SOURCE"),
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "inheritances.#", "1"),
+					resource.TestCheckResourceAttr("cortex_entity_relationship.test", "id", stub.Tag+":manual-test:manual-test-service"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      stub.ResourceFullName(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "cortex_entity_relationship.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: func() string {
+					updated := stub
+					updated.Name = "Runs on (updated)"
+					updated.Cardinality = "MANY_TO_MANY"
+					return updated.ToTerraform()
+				}(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "name", "Runs on (updated)"),
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "cardinality", "MANY_TO_MANY"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+/***********************************************************************************************************************
+ * Models
+ **********************************************************************************************************************/
+
+// RoleResourceModel describes the custom role data model within Terraform.
+type RoleResourceModel struct {
+	Id          types.String   `tfsdk:"id"`
+	Tag         types.String   `tfsdk:"tag"`
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	Permissions []types.String `tfsdk:"permissions"`
+}
+
+func NewRoleResourceModel() RoleResourceModel {
+	return RoleResourceModel{}
+}
+
+func (o *RoleResourceModel) ToApiModel() cortex.Role {
+	entity := cortex.Role{
+		Tag:         o.Tag.ValueString(),
+		Name:        o.Name.ValueString(),
+		Description: o.Description.ValueString(),
+		Permissions: make([]string, len(o.Permissions)),
+	}
+	for i, permission := range o.Permissions {
+		entity.Permissions[i] = permission.ValueString()
+	}
+	return entity
+}
+
+func (o *RoleResourceModel) FromApiModel(entity cortex.Role) {
+	o.Id = types.StringValue(entity.Tag)
+	o.Tag = types.StringValue(entity.Tag)
+	o.Name = types.StringValue(entity.Name)
+	o.Description = stringValueOrNull(entity.Description)
+
+	o.Permissions = make([]types.String, len(entity.Permissions))
+	for i, permission := range entity.Permissions {
+		o.Permissions[i] = types.StringValue(permission)
+	}
+}
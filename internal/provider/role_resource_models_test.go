@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoleResourceModelRoundTrip(t *testing.T) {
+	entity := cortex.Role{
+		Tag:         "deployer",
+		Name:        "Deployer",
+		Permissions: []string{"VIEW_CATALOG", "EDIT_DEPLOYS"},
+	}
+
+	data := NewRoleResourceModel()
+	data.FromApiModel(entity)
+	assert.Equal(t, "deployer", data.Id.ValueString())
+	assert.True(t, data.Description.IsNull())
+	assert.Equal(t, entity, data.ToApiModel())
+}
+
+func TestRoleAssignmentResourceModelRoundTrip(t *testing.T) {
+	entity := cortex.RoleAssignment{
+		Id:        "ra-1",
+		RoleTag:   "deployer",
+		UserEmail: "someone@example.com",
+	}
+
+	data := NewRoleAssignmentResourceModel()
+	data.FromApiModel(entity)
+	assert.True(t, data.TeamTag.IsNull())
+	assert.Equal(t, entity, data.ToApiModel())
+}
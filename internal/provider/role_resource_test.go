@@ -0,0 +1,68 @@
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRoleResource(t *testing.T) {
+	tag := "test-role"
+	resourceName := "cortex_role." + tag
+	assignmentName := "cortex_role_assignment." + tag
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccRoleResourceConfig(tag, "A test role", `["VIEW_CATALOG"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tag", tag),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "1"),
+					resource.TestCheckResourceAttr(assignmentName, "role_tag", tag),
+					resource.TestCheckResourceAttr(assignmentName, "team_tag", "test-team-1"),
+					resource.TestCheckResourceAttrSet(assignmentName, "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      assignmentName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccRoleResourceConfig(tag, "An updated test role", `["VIEW_CATALOG", "EDIT_DEPLOYS"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "An updated test role"),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccRoleResourceConfig(tag string, description string, permissions string) string {
+	return fmt.Sprintf(`
+resource "cortex_role" %[1]q {
+  tag         = %[1]q
+  name        = "Test Role"
+  description = %[2]q
+  permissions = %[3]s
+}
+
+resource "cortex_role_assignment" %[1]q {
+  role_tag = cortex_role.%[1]s.tag
+  team_tag = "test-team-1"
+}
+`, tag, description, permissions)
+}
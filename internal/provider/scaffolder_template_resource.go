@@ -0,0 +1,298 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ScaffolderTemplateResource{}
+var _ resource.ResourceWithImportState = &ScaffolderTemplateResource{}
+
+func NewScaffolderTemplateResource() resource.Resource {
+	return &ScaffolderTemplateResource{}
+}
+
+/***********************************************************************************************************************
+ * Types
+ **********************************************************************************************************************/
+
+// ScaffolderTemplateResource defines the resource implementation.
+type ScaffolderTemplateResource struct {
+	client *cortex.HttpClient
+}
+
+/***********************************************************************************************************************
+ * Schema
+ **********************************************************************************************************************/
+
+func (r *ScaffolderTemplateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Scaffolder Template. Registers a Cookiecutter template with the Cortex Scaffolder, which creates new repositories, and catalog entities for them, from the template.",
+
+		Attributes: map[string]schema.Attribute{
+			// Required attributes
+			"tag": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier for the template. **Note:** Changing this attribute will force replacement of the resource.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the template.",
+				Required:            true,
+			},
+			"repository_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the Git repository containing the Cookiecutter template.",
+				Required:            true,
+			},
+			"git_provider": schema.SingleNestedAttribute{
+				MarkdownDescription: "Git provider that repositories created from the template are pushed to.",
+				Required:            true,
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: "Type of the Git provider: `GITHUB`, `GITLAB`, `BITBUCKET` or `AZURE_DEVOPS`.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								cortex.ScaffolderTemplateGitProviderGithub,
+								cortex.ScaffolderTemplateGitProviderGitlab,
+								cortex.ScaffolderTemplateGitProviderBitbucket,
+								cortex.ScaffolderTemplateGitProviderAzureDevops,
+							),
+						},
+					},
+					"alias": schema.StringAttribute{
+						MarkdownDescription: "Alias of the integration configuration to use, if more than one is configured for the Git provider.",
+						Optional:            true,
+					},
+					"organization": schema.StringAttribute{
+						MarkdownDescription: "Organization, group or project to create repositories in.",
+						Optional:            true,
+					},
+				},
+			},
+
+			// Optional attributes
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the template.",
+				Optional:            true,
+			},
+			"branch": schema.StringAttribute{
+				MarkdownDescription: "Branch of the template repository to use. If omitted, the default branch is used.",
+				Optional:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Directory within the template repository containing `cookiecutter.json`. If omitted, the root of the repository is used.",
+				Optional:            true,
+			},
+			"inputs": schema.ListNestedAttribute{
+				MarkdownDescription: "Overrides for how the template's Cookiecutter variables are presented to users.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the Cookiecutter variable.",
+							Required:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description shown to the user.",
+							Optional:            true,
+						},
+						"default_value": schema.StringAttribute{
+							MarkdownDescription: "Default value, overriding the one in `cookiecutter.json`.",
+							Optional:            true,
+						},
+						"required": schema.BoolAttribute{
+							MarkdownDescription: "Whether the user must provide a value. Defaults to `false`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+					},
+				},
+			},
+			"default_owners": schema.ListNestedAttribute{
+				MarkdownDescription: "Owners assigned to catalog entities created from the template.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of owner. Valid values are `EMAIL` or `GROUP`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(
+									cortex.ScaffolderTemplateOwnerTypeEmail,
+									cortex.ScaffolderTemplateOwnerTypeGroup,
+								),
+							},
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the owner. Only allowed if `type` is `GROUP`.",
+							Optional:            true,
+						},
+						"email": schema.StringAttribute{
+							MarkdownDescription: "Email of the owner. Only allowed if `type` is `EMAIL`.",
+							Optional:            true,
+						},
+						"provider": schema.StringAttribute{
+							MarkdownDescription: "Provider of the owner. Only allowed if `type` is `GROUP`.",
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("ACTIVE_DIRECTORY", "BAMBOO_HR", "CORTEX", "GITHUB", "GITLAB", "GOOGLE", "OKTA", "OPSGENIE", "SERVICE_NOW", "WORKDAY"),
+							},
+						},
+					},
+				},
+			},
+			"default_groups": schema.ListAttribute{
+				MarkdownDescription: "Groups assigned to catalog entities created from the template.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+
+			// Computed attributes
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+/***********************************************************************************************************************
+ * Methods
+ **********************************************************************************************************************/
+
+func (r *ScaffolderTemplateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scaffolder_template"
+}
+
+func (r *ScaffolderTemplateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cortex.HttpClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ScaffolderTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	data := NewScaffolderTemplateResourceModel()
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Issue API request
+	entity, err := r.client.ScaffolderTemplates().Get(ctx, data.Id.ValueString())
+	if err != nil {
+		if errors.Is(err, cortex.ApiErrorNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read scaffolder template %s, got error: %s", data.Id.ValueString(), err))
+		return
+	}
+
+	// Map data from the API response to the model
+	data.FromApiModel(entity)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ScaffolderTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	data := NewScaffolderTemplateResourceModel()
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientEntity := data.ToApiModel()
+	entity, err := r.client.ScaffolderTemplates().Create(ctx, clientEntity.ToUpsertRequest())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create scaffolder template, got error: %s", err))
+		return
+	}
+
+	data.FromApiModel(entity)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ScaffolderTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	data := NewScaffolderTemplateResourceModel()
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientEntity := data.ToApiModel()
+	entity, err := r.client.ScaffolderTemplates().Update(ctx, data.Tag.ValueString(), clientEntity.ToUpsertRequest())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update scaffolder template, got error: %s", err))
+		return
+	}
+
+	data.FromApiModel(entity)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ScaffolderTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	data := NewScaffolderTemplateResourceModel()
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.ScaffolderTemplates().Delete(ctx, data.Tag.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete scaffolder template, got error: %s", err))
+		return
+	}
+}
+
+func (r *ScaffolderTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
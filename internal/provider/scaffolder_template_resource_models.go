@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+/***********************************************************************************************************************
+ * Models
+ **********************************************************************************************************************/
+
+// ScaffolderTemplateResourceModel describes the scaffolder template data model within Terraform.
+type ScaffolderTemplateResourceModel struct {
+	Id            types.String                                `tfsdk:"id"`
+	Tag           types.String                                `tfsdk:"tag"`
+	Name          types.String                                `tfsdk:"name"`
+	Description   types.String                                `tfsdk:"description"`
+	RepositoryUrl types.String                                `tfsdk:"repository_url"`
+	Branch        types.String                                `tfsdk:"branch"`
+	Path          types.String                                `tfsdk:"path"`
+	Inputs        []ScaffolderTemplateInputResourceModel      `tfsdk:"inputs"`
+	DefaultOwners []ScaffolderTemplateOwnerResourceModel      `tfsdk:"default_owners"`
+	DefaultGroups []types.String                              `tfsdk:"default_groups"`
+	GitProvider   *ScaffolderTemplateGitProviderResourceModel `tfsdk:"git_provider"`
+}
+
+type ScaffolderTemplateInputResourceModel struct {
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	DefaultValue types.String `tfsdk:"default_value"`
+	Required     types.Bool   `tfsdk:"required"`
+}
+
+type ScaffolderTemplateOwnerResourceModel struct {
+	Type     types.String `tfsdk:"type"`
+	Name     types.String `tfsdk:"name"`
+	Email    types.String `tfsdk:"email"`
+	Provider types.String `tfsdk:"provider"`
+}
+
+type ScaffolderTemplateGitProviderResourceModel struct {
+	Type         types.String `tfsdk:"type"`
+	Alias        types.String `tfsdk:"alias"`
+	Organization types.String `tfsdk:"organization"`
+}
+
+func NewScaffolderTemplateResourceModel() ScaffolderTemplateResourceModel {
+	return ScaffolderTemplateResourceModel{}
+}
+
+func (o *ScaffolderTemplateResourceModel) ToApiModel() cortex.ScaffolderTemplate {
+	entity := cortex.ScaffolderTemplate{
+		Tag:           o.Tag.ValueString(),
+		Name:          o.Name.ValueString(),
+		Description:   o.Description.ValueString(),
+		RepositoryUrl: o.RepositoryUrl.ValueString(),
+		Branch:        o.Branch.ValueString(),
+		Path:          o.Path.ValueString(),
+		Inputs:        make([]cortex.ScaffolderTemplateInput, len(o.Inputs)),
+		DefaultOwners: make([]cortex.ScaffolderTemplateOwner, len(o.DefaultOwners)),
+		DefaultGroups: make([]string, len(o.DefaultGroups)),
+	}
+	for i, input := range o.Inputs {
+		entity.Inputs[i] = cortex.ScaffolderTemplateInput{
+			Name:         input.Name.ValueString(),
+			Description:  input.Description.ValueString(),
+			DefaultValue: input.DefaultValue.ValueString(),
+			Required:     input.Required.ValueBool(),
+		}
+	}
+	for i, owner := range o.DefaultOwners {
+		entity.DefaultOwners[i] = cortex.ScaffolderTemplateOwner{
+			Type:     owner.Type.ValueString(),
+			Name:     owner.Name.ValueString(),
+			Email:    owner.Email.ValueString(),
+			Provider: owner.Provider.ValueString(),
+		}
+	}
+	for i, group := range o.DefaultGroups {
+		entity.DefaultGroups[i] = group.ValueString()
+	}
+	if o.GitProvider != nil {
+		entity.GitProvider = cortex.ScaffolderTemplateGitProvider{
+			Type:         o.GitProvider.Type.ValueString(),
+			Alias:        o.GitProvider.Alias.ValueString(),
+			Organization: o.GitProvider.Organization.ValueString(),
+		}
+	}
+	return entity
+}
+
+func (o *ScaffolderTemplateResourceModel) FromApiModel(entity cortex.ScaffolderTemplate) {
+	o.Id = types.StringValue(entity.Tag)
+	o.Tag = types.StringValue(entity.Tag)
+	o.Name = types.StringValue(entity.Name)
+	o.Description = stringValueOrNull(entity.Description)
+	o.RepositoryUrl = types.StringValue(entity.RepositoryUrl)
+	o.Branch = stringValueOrNull(entity.Branch)
+	o.Path = stringValueOrNull(entity.Path)
+
+	o.Inputs = nil
+	for _, input := range entity.Inputs {
+		o.Inputs = append(o.Inputs, ScaffolderTemplateInputResourceModel{
+			Name:         types.StringValue(input.Name),
+			Description:  stringValueOrNull(input.Description),
+			DefaultValue: stringValueOrNull(input.DefaultValue),
+			Required:     types.BoolValue(input.Required),
+		})
+	}
+	o.DefaultOwners = nil
+	for _, owner := range entity.DefaultOwners {
+		o.DefaultOwners = append(o.DefaultOwners, ScaffolderTemplateOwnerResourceModel{
+			Type:     types.StringValue(owner.Type),
+			Name:     stringValueOrNull(owner.Name),
+			Email:    stringValueOrNull(owner.Email),
+			Provider: stringValueOrNull(owner.Provider),
+		})
+	}
+	o.DefaultGroups = nil
+	for _, group := range entity.DefaultGroups {
+		o.DefaultGroups = append(o.DefaultGroups, types.StringValue(group))
+	}
+	o.GitProvider = &ScaffolderTemplateGitProviderResourceModel{
+		Type:         types.StringValue(entity.GitProvider.Type),
+		Alias:        stringValueOrNull(entity.GitProvider.Alias),
+		Organization: stringValueOrNull(entity.GitProvider.Organization),
+	}
+}
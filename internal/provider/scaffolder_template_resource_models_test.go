@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScaffolderTemplateResourceModelRoundTrip(t *testing.T) {
+	entity := cortex.ScaffolderTemplate{
+		Tag:           "go-service",
+		Name:          "Go service",
+		RepositoryUrl: "https://github.com/cortexapps/cookiecutter-go-service",
+		Path:          "templates/http",
+		Inputs: []cortex.ScaffolderTemplateInput{
+			{Name: "service_name", Description: "Name of the new service", Required: true},
+			{Name: "go_version", DefaultValue: "1.24"},
+		},
+		DefaultOwners: []cortex.ScaffolderTemplateOwner{
+			{Type: cortex.ScaffolderTemplateOwnerTypeEmail, Email: "platform@example.com"},
+		},
+		DefaultGroups: []string{"golden-path"},
+		GitProvider: cortex.ScaffolderTemplateGitProvider{
+			Type:  cortex.ScaffolderTemplateGitProviderGitlab,
+			Alias: "self-hosted",
+		},
+	}
+
+	model := NewScaffolderTemplateResourceModel()
+	model.FromApiModel(entity)
+	assert.Equal(t, "go-service", model.Id.ValueString())
+	assert.True(t, model.Description.IsNull())
+	assert.True(t, model.Branch.IsNull())
+	assert.True(t, model.Inputs[0].DefaultValue.IsNull())
+	assert.True(t, model.DefaultOwners[0].Name.IsNull())
+	assert.True(t, model.GitProvider.Organization.IsNull())
+
+	assert.Equal(t, entity, model.ToApiModel())
+}
+
+func TestScaffolderTemplateResourceModelEmptyLists(t *testing.T) {
+	model := NewScaffolderTemplateResourceModel()
+	model.FromApiModel(cortex.ScaffolderTemplate{Tag: "go-service"})
+	assert.Nil(t, model.Inputs)
+	assert.Nil(t, model.DefaultOwners)
+	assert.Nil(t, model.DefaultGroups)
+
+	// The API expects empty lists rather than nulls when nothing is configured.
+	entity := model.ToApiModel()
+	assert.NotNil(t, entity.Inputs)
+	assert.NotNil(t, entity.DefaultOwners)
+	assert.NotNil(t, entity.DefaultGroups)
+}
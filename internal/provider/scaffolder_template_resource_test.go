@@ -0,0 +1,108 @@
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+type testScaffolderTemplateResource struct {
+	Tag    string
+	Name   string
+	Branch string
+}
+
+func (t *testScaffolderTemplateResource) ResourceFullName() string {
+	return t.ResourceType() + "." + t.Tag
+}
+
+func (t *testScaffolderTemplateResource) ResourceType() string {
+	return "cortex_scaffolder_template"
+}
+
+func (t *testScaffolderTemplateResource) ToTerraform() string {
+	return fmt.Sprintf(`
+resource %[1]q %[2]q {
+  tag            = %[2]q
+  name           = %[3]q
+  repository_url = "https://github.com/cortexapps/cookiecutter-go-service"
+  branch         = %[4]q
+
+  git_provider = {
+    type         = "GITHUB"
+    organization = "cortexapps"
+  }
+
+  inputs = [
+    {
+      name        = "service_name"
+      description = "Name of the new service"
+      required    = true
+    },
+    {
+      name          = "go_version"
+      default_value = "1.24"
+    }
+  ]
+
+  default_owners = [
+    {
+      type  = "EMAIL"
+      email = "platform@cortex.io"
+    }
+  ]
+  default_groups = ["golden-path"]
+}
+
+data "cortex_scaffolder_templates" "all" {
+  depends_on = [%[1]s.%[2]s]
+}`, t.ResourceType(), t.Tag, t.Name, t.Branch)
+}
+
+func TestAccScaffolderTemplateResource(t *testing.T) {
+	stub := testScaffolderTemplateResource{
+		Tag:    "terraform-test-go-service",
+		Name:   "Go service",
+		Branch: "main",
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: stub.ToTerraform(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "id", stub.Tag),
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "name", stub.Name),
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "git_provider.type", "GITHUB"),
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "inputs.#", "2"),
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "inputs.1.required", "false"),
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "default_owners.0.email", "platform@cortex.io"),
+					resource.TestCheckResourceAttrSet("data.cortex_scaffolder_templates.all", "templates.#"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      stub.ResourceFullName(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: func() string {
+					updated := stub
+					updated.Name = "Go service (updated)"
+					updated.Branch = "v2"
+					return updated.ToTerraform()
+				}(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "name", "Go service (updated)"),
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "branch", "v2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
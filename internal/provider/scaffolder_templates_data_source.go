@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ScaffolderTemplatesDataSource{}
+
+func NewScaffolderTemplatesDataSource() datasource.DataSource {
+	return &ScaffolderTemplatesDataSource{}
+}
+
+// ScaffolderTemplatesDataSource defines the data source implementation.
+type ScaffolderTemplatesDataSource struct {
+	client *cortex.HttpClient
+}
+
+// ScaffolderTemplatesDataSourceModel describes the data source data model.
+type ScaffolderTemplatesDataSourceModel struct {
+	Id        types.String                            `tfsdk:"id"`
+	Templates []ScaffolderTemplateDataSourceItemModel `tfsdk:"templates"`
+}
+
+// ScaffolderTemplateDataSourceItemModel represents a single scaffolder template in the list.
+type ScaffolderTemplateDataSourceItemModel struct {
+	Tag           types.String                                `tfsdk:"tag"`
+	Name          types.String                                `tfsdk:"name"`
+	Description   types.String                                `tfsdk:"description"`
+	RepositoryUrl types.String                                `tfsdk:"repository_url"`
+	Branch        types.String                                `tfsdk:"branch"`
+	Path          types.String                                `tfsdk:"path"`
+	GitProvider   *ScaffolderTemplateGitProviderResourceModel `tfsdk:"git_provider"`
+}
+
+func (d *ScaffolderTemplatesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scaffolder_templates"
+}
+
+func (d *ScaffolderTemplatesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Scaffolder Templates data source - returns a list of all scaffolder templates",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Internal identifier for this data source",
+			},
+			"templates": schema.ListNestedAttribute{
+				MarkdownDescription: "List of all scaffolder templates",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"tag": schema.StringAttribute{
+							MarkdownDescription: "Tag of the template",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the template",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the template",
+							Computed:            true,
+						},
+						"repository_url": schema.StringAttribute{
+							MarkdownDescription: "URL of the Git repository containing the Cookiecutter template",
+							Computed:            true,
+						},
+						"branch": schema.StringAttribute{
+							MarkdownDescription: "Branch of the template repository",
+							Computed:            true,
+						},
+						"path": schema.StringAttribute{
+							MarkdownDescription: "Directory within the template repository containing `cookiecutter.json`",
+							Computed:            true,
+						},
+						"git_provider": schema.SingleNestedAttribute{
+							MarkdownDescription: "Git provider that repositories created from the template are pushed to",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"type": schema.StringAttribute{
+									Computed: true,
+								},
+								"alias": schema.StringAttribute{
+									Computed: true,
+								},
+								"organization": schema.StringAttribute{
+									Computed: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ScaffolderTemplatesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cortex.HttpClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *cortex.HttpClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ScaffolderTemplatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ScaffolderTemplatesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fetch all pages of results
+	params := &cortex.ScaffolderTemplateListParams{
+		PageSize: 250,
+		Page:     0,
+	}
+	templates := []ScaffolderTemplateDataSourceItemModel{}
+	for {
+		templatesResponse, err := d.client.ScaffolderTemplates().List(ctx, params)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read scaffolder templates, got error: %s", err))
+			return
+		}
+
+		for _, template := range templatesResponse.Templates {
+			templates = append(templates, ScaffolderTemplateDataSourceItemModel{
+				Tag:           types.StringValue(template.Tag),
+				Name:          types.StringValue(template.Name),
+				Description:   stringValueOrNull(template.Description),
+				RepositoryUrl: types.StringValue(template.RepositoryUrl),
+				Branch:        stringValueOrNull(template.Branch),
+				Path:          stringValueOrNull(template.Path),
+				GitProvider: &ScaffolderTemplateGitProviderResourceModel{
+					Type:         types.StringValue(template.GitProvider.Type),
+					Alias:        stringValueOrNull(template.GitProvider.Alias),
+					Organization: stringValueOrNull(template.GitProvider.Organization),
+				},
+			})
+		}
+
+		if templatesResponse.Page >= templatesResponse.TotalPages-1 || len(templatesResponse.Templates) == 0 {
+			break
+		}
+		params.Page++
+	}
+
+	data.Id = types.StringValue("scaffolder_templates")
+	data.Templates = templates
+
+	// Write to TF state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
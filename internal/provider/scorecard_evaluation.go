@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const defaultScorecardEvaluationTimeout = "10m"
+
+// scorecardEvaluationPollInterval is how often the scores are checked while waiting for an evaluation to finish.
+var scorecardEvaluationPollInterval = 10 * time.Second
+
+// evaluate re-evaluates the scorecard if evaluate_on_change is configured, optionally waiting for the new scores. It runs
+// after the scorecard has been saved, so failures are reported as warnings; an error would taint the scorecard.
+func (r *ScorecardResource) evaluate(ctx context.Context, diagnostics *diag.Diagnostics, data ScorecardResourceModel) {
+	if data.EvaluateOnChange.IsNull() || data.EvaluateOnChange.IsUnknown() {
+		return
+	}
+
+	opts := ScorecardEvaluateOnChangeResourceModel{}
+	diagnostics.Append(data.EvaluateOnChange.As(ctx, &opts, getDefaultObjectOptions())...)
+	if diagnostics.HasError() {
+		return
+	}
+
+	timeout, err := time.ParseDuration(opts.Timeout.ValueString())
+	if err != nil {
+		diagnostics.AddAttributeWarning(path.Root("evaluate_on_change").AtName("timeout"), "Invalid timeout", err.Error())
+		return
+	}
+
+	err = evaluateScorecard(ctx, r.client.Scorecards(), data.Tag.ValueString(), opts.EntityTag.ValueString(), opts.Wait.ValueBool(), timeout)
+	if err != nil {
+		diagnostics.AddWarning("Scorecard Not Evaluated", fmt.Sprintf("Scorecard %s was saved, but could not be evaluated; it will be evaluated on its normal schedule. Got error: %s", data.Tag.ValueString(), err))
+	}
+}
+
+// evaluateScorecard triggers an evaluation and, if wait is set, polls until every entity's last evaluation time has
+// changed. Comparing against the previous evaluation times, rather than the local clock, avoids depending on clock
+// skew between Terraform and Cortex.
+func evaluateScorecard(ctx context.Context, client cortex.ScorecardsClientInterface, tag string, entityTag string, wait bool, timeout time.Duration) error {
+	var before map[string]string
+	if wait {
+		var err error
+		before, err = client.LastEvaluated(ctx, tag, entityTag)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := client.Evaluate(ctx, tag, entityTag); err != nil {
+		return err
+	}
+	if !wait {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(scorecardEvaluationPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return fmt.Errorf("timed out after %s waiting for the evaluation to finish", timeout)
+			}
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		after, err := client.LastEvaluated(ctx, tag, entityTag)
+		if err != nil {
+			if ctx.Err() != nil {
+				continue
+			}
+			return err
+		}
+		pending := 0
+		for entity, lastEvaluated := range after {
+			if lastEvaluated == "" || lastEvaluated == before[entity] {
+				pending++
+			}
+		}
+		if pending == 0 {
+			return nil
+		}
+		tflog.Debug(ctx, "Waiting for scorecard evaluation", map[string]interface{}{
+			"scorecard_tag":    tag,
+			"pending_entities": pending,
+		})
+	}
+}
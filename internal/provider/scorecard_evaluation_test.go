@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// newScorecardEvaluationServer serves the scores of a scorecard with a single entity. Once the scorecard is evaluated,
+// the entity's last evaluation time changes after evaluationPolls polls of the scores.
+func newScorecardEvaluationServer(t *testing.T, evaluationPolls int32) (*httptest.Server, *atomic.Int32) {
+	evaluations := &atomic.Int32{}
+	polls := &atomic.Int32{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/scorecards/test-scorecard/evaluate", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		evaluations.Add(1)
+		_, _ = w.Write([]byte(`{}`))
+	})
+	mux.HandleFunc("/api/v1/scorecards/test-scorecard/scores", func(w http.ResponseWriter, r *http.Request) {
+		lastEvaluated := "2024-01-01T00:00:00Z"
+		if evaluations.Load() > 0 && polls.Add(1) > evaluationPolls {
+			lastEvaluated = "2024-01-02T00:00:00Z"
+		}
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"scorecardTag":"test-scorecard","serviceScores":[{"service":{"tag":"test-service"},"lastEvaluated":%q}],"page":0,"totalPages":1}`, lastEvaluated)))
+	})
+	return httptest.NewServer(mux), evaluations
+}
+
+func TestEvaluateScorecard(t *testing.T) {
+	interval := scorecardEvaluationPollInterval
+	scorecardEvaluationPollInterval = 10 * time.Millisecond
+	defer func() { scorecardEvaluationPollInterval = interval }()
+
+	tests := []struct {
+		name            string
+		wait            bool
+		evaluationPolls int32
+		timeout         time.Duration
+		err             string
+	}{
+		{name: "without waiting", wait: false, evaluationPolls: 100, timeout: time.Minute},
+		{name: "waits for new scores", wait: true, evaluationPolls: 2, timeout: time.Minute},
+		{name: "times out", wait: true, evaluationPolls: 1000, timeout: 50 * time.Millisecond, err: "timed out after 50ms"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, evaluations := newScorecardEvaluationServer(t, tt.evaluationPolls)
+			defer server.Close()
+			client, err := cortex.NewClient(cortex.WithURL(server.URL), cortex.WithToken("test-token"))
+			assert.Nil(t, err)
+
+			err = evaluateScorecard(context.Background(), client.Scorecards(), "test-scorecard", "", tt.wait, tt.timeout)
+			if tt.err != "" {
+				assert.ErrorContains(t, err, tt.err)
+			} else {
+				assert.Nil(t, err)
+			}
+			assert.Equal(t, int32(1), evaluations.Load())
+		})
+	}
+}
+
+func TestEvaluateReportsFailuresAsWarnings(t *testing.T) {
+	interval := scorecardEvaluationPollInterval
+	scorecardEvaluationPollInterval = 10 * time.Millisecond
+	defer func() { scorecardEvaluationPollInterval = interval }()
+
+	server, _ := newScorecardEvaluationServer(t, 1000)
+	defer server.Close()
+	client, err := cortex.NewClient(cortex.WithURL(server.URL), cortex.WithToken("test-token"))
+	assert.Nil(t, err)
+
+	evaluateOnChange, diags := types.ObjectValue(
+		map[string]attr.Type{"entity_tag": types.StringType, "wait": types.BoolType, "timeout": types.StringType},
+		map[string]attr.Value{"entity_tag": types.StringNull(), "wait": types.BoolValue(true), "timeout": types.StringValue("50ms")},
+	)
+	assert.False(t, diags.HasError())
+	data := NewScorecardResourceModel()
+	data.Tag = types.StringValue("test-scorecard")
+	data.EvaluateOnChange = evaluateOnChange
+
+	diagnostics := diag.Diagnostics{}
+	r := &ScorecardResource{client: client}
+	r.evaluate(context.Background(), &diagnostics, data)
+	assert.False(t, diagnostics.HasError())
+	assert.Equal(t, 1, diagnostics.WarningsCount())
+}
@@ -3,7 +3,11 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 
 	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
@@ -196,6 +200,32 @@ func (r *ScorecardResource) Schema(ctx context.Context, req resource.SchemaReque
 				},
 			},
 
+			"evaluate_on_change": schema.SingleNestedAttribute{
+				MarkdownDescription: "Re-evaluate the scorecard after it is created or updated, instead of waiting for the next scheduled evaluation. Evaluation is best-effort: if it fails or times out, a warning is shown and the scorecard is still saved.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"entity_tag": schema.StringAttribute{
+						MarkdownDescription: "Only re-evaluate the entity with this tag.",
+						Optional:            true,
+					},
+					"wait": schema.BoolAttribute{
+						MarkdownDescription: "Wait until the scores of every re-evaluated entity have been updated. Defaults to `false`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"timeout": schema.StringAttribute{
+						MarkdownDescription: "How long to wait for the evaluation to finish, as a duration such as `30s` or `10m`. Defaults to `10m`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString(defaultScorecardEvaluationTimeout),
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(regexp.MustCompile(`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`), "must be a duration such as 30s or 10m"),
+						},
+					},
+				},
+			},
+
 			// Computed attributes
 			"id": schema.StringAttribute{
 				Computed: true,
@@ -284,6 +314,8 @@ func (r *ScorecardResource) Create(ctx context.Context, req resource.CreateReque
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	r.evaluate(ctx, &resp.Diagnostics, data)
 }
 
 func (r *ScorecardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -310,6 +342,8 @@ func (r *ScorecardResource) Update(ctx context.Context, req resource.UpdateReque
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	r.evaluate(ctx, &resp.Diagnostics, data)
 }
 
 func (r *ScorecardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -15,15 +15,16 @@ import (
 
 // ScorecardResourceModel describes the scorecard data model within Terraform.
 type ScorecardResourceModel struct {
-	Id          types.String                 `tfsdk:"id"`
-	Tag         types.String                 `tfsdk:"tag"`
-	Name        types.String                 `tfsdk:"name"`
-	Description types.String                 `tfsdk:"description"`
-	Draft       types.Bool                   `tfsdk:"draft"`
-	Ladder      types.Object                 `tfsdk:"ladder"`
-	Rules       []ScorecardRuleResourceModel `tfsdk:"rules"`
-	Filter      types.Object                 `tfsdk:"filter"`
-	Evaluation  types.Object                 `tfsdk:"evaluation"`
+	Id               types.String                 `tfsdk:"id"`
+	Tag              types.String                 `tfsdk:"tag"`
+	Name             types.String                 `tfsdk:"name"`
+	Description      types.String                 `tfsdk:"description"`
+	Draft            types.Bool                   `tfsdk:"draft"`
+	Ladder           types.Object                 `tfsdk:"ladder"`
+	Rules            []ScorecardRuleResourceModel `tfsdk:"rules"`
+	Filter           types.Object                 `tfsdk:"filter"`
+	Evaluation       types.Object                 `tfsdk:"evaluation"`
+	EvaluateOnChange types.Object                 `tfsdk:"evaluate_on_change"`
 }
 
 type ScorecardLadderResourceModel struct {
@@ -66,6 +67,14 @@ type ScorecardEvaluationResourceModel struct {
 	Window types.Int64 `tfsdk:"window"`
 }
 
+// ScorecardEvaluateOnChangeResourceModel configures re-evaluation after the scorecard changes. It only affects how the
+// provider applies changes, so it is never read back from the API.
+type ScorecardEvaluateOnChangeResourceModel struct {
+	EntityTag types.String `tfsdk:"entity_tag"`
+	Wait      types.Bool   `tfsdk:"wait"`
+	Timeout   types.String `tfsdk:"timeout"`
+}
+
 /***********************************************************************************************************************
  * Methods
  **********************************************************************************************************************/
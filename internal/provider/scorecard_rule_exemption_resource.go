@@ -0,0 +1,314 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ScorecardRuleExemptionResource{}
+var _ resource.ResourceWithImportState = &ScorecardRuleExemptionResource{}
+
+func NewScorecardRuleExemptionResource() resource.Resource {
+	return &ScorecardRuleExemptionResource{}
+}
+
+func NewScorecardRuleExemptionResourceModel() ScorecardRuleExemptionResourceModel {
+	return ScorecardRuleExemptionResourceModel{}
+}
+
+// scorecardRuleExemptionRevokeReason is recorded in Cortex when an exemption is revoked because it was removed from
+// the Terraform configuration.
+const scorecardRuleExemptionRevokeReason = "Removed from Terraform configuration"
+
+// scorecardRuleExemptionDaysRequiresReplace requires replacement when days changes, except when days is unset in state
+// for an exemption that already expires. The API returns the end date rather than the number of days the exemption was
+// requested for, so days is never read back and is null after import; replacing the exemption then would revoke it and
+// request it again on the first plan.
+func scorecardRuleExemptionDaysRequiresReplace(ctx context.Context, req planmodifier.Int64Request, resp *int64planmodifier.RequiresReplaceIfFuncResponse) {
+	if !req.StateValue.IsNull() {
+		resp.RequiresReplace = true
+		return
+	}
+
+	var expiresAt types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("expires_at"), &expiresAt)...)
+	resp.RequiresReplace = expiresAt.ValueString() == ""
+}
+
+/***********************************************************************************************************************
+ * Types
+ **********************************************************************************************************************/
+
+// ScorecardRuleExemptionResource defines the resource implementation.
+type ScorecardRuleExemptionResource struct {
+	client *cortex.HttpClient
+}
+
+/***********************************************************************************************************************
+ * Schema
+ **********************************************************************************************************************/
+
+func (r *ScorecardRuleExemptionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Scorecard Rule Exemption. Exempts an entity from a single scorecard rule. Expired exemptions remain in state with status `EXPIRED` rather than being requested again; change `reason` or `days` to renew one. Destroying the resource revokes the exemption.",
+
+		Attributes: map[string]schema.Attribute{
+			// Required attributes
+			"scorecard_tag": schema.StringAttribute{
+				MarkdownDescription: "Tag of the scorecard.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"entity_tag": schema.StringAttribute{
+				MarkdownDescription: "Tag of the exempted entity.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rule_identifier": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the scorecard rule the entity is exempted from.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"reason": schema.StringAttribute{
+				MarkdownDescription: "Why the entity is exempt.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Optional attributes
+			"days": schema.Int64Attribute{
+				MarkdownDescription: "Number of days after which the exemption expires. If omitted, the exemption does not expire. The API does not return this, so it is not set on import; setting it on an imported exemption that already expires is recorded in state without requesting the exemption again.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplaceIf(
+						scorecardRuleExemptionDaysRequiresReplace,
+						"Changing days requests the exemption again, unless the exemption already expires and days is unset in state, such as after an import.",
+						"Changing `days` requests the exemption again, unless the exemption already expires and `days` is unset in state, such as after an import.",
+					),
+				},
+			},
+			"auto_approve": schema.BoolAttribute{
+				MarkdownDescription: "Whether to approve the exemption immediately after requesting it, which requires permission to approve exemptions. If `false`, the exemption stays `PENDING` until approved in Cortex. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+
+			// Computed attributes
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the exemption: `PENDING`, `APPROVED` or `EXPIRED`.",
+				Computed:            true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "When the exemption expires.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+/***********************************************************************************************************************
+ * Methods
+ **********************************************************************************************************************/
+
+func (r *ScorecardRuleExemptionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scorecard_rule_exemption"
+}
+
+func (r *ScorecardRuleExemptionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cortex.HttpClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ScorecardRuleExemptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	data := NewScorecardRuleExemptionResourceModel()
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Issue API request
+	entity, err := r.client.ScorecardRuleExemptions().Get(ctx, data.ScorecardTag.ValueString(), data.EntityTag.ValueString(), data.RuleIdentifier.ValueString())
+	if err != nil {
+		if errors.Is(err, cortex.ApiErrorNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read scorecard rule exemption %s, got error: %s", data.Id.ValueString(), err))
+		return
+	}
+
+	// Exemptions that were revoked or rejected outside of Terraform need to be requested again.
+	if !entity.Active() && entity.Status != cortex.ScorecardRuleExemptionStatusExpired {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Imported exemptions have no configured auto_approve; infer it from whether the exemption was approved.
+	if data.AutoApprove.IsNull() {
+		data.AutoApprove = types.BoolValue(entity.Status != cortex.ScorecardRuleExemptionStatusPending)
+	}
+
+	data.FromApiModel(entity)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create Requests a new exemption, approving it if auto_approve is set.
+func (r *ScorecardRuleExemptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	data := NewScorecardRuleExemptionResourceModel()
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entity, err := r.client.ScorecardRuleExemptions().Request(ctx, data.ScorecardTag.ValueString(), data.EntityTag.ValueString(), data.ToRequestApiModel())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to request scorecard rule exemption, got error: %s", err))
+		return
+	}
+
+	if data.AutoApprove.ValueBool() && entity.Status == cortex.ScorecardRuleExemptionStatusPending {
+		entity, err = r.client.ScorecardRuleExemptions().Approve(ctx, data.ScorecardTag.ValueString(), data.EntityTag.ValueString(), data.RuleIdentifier.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to approve scorecard rule exemption, got error: %s", err))
+			return
+		}
+	}
+
+	data.FromApiModel(entity)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update Approves a pending exemption when auto_approve is enabled, and records days set on an exemption that already
+// expires; every other change forces replacement.
+func (r *ScorecardRuleExemptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	data := NewScorecardRuleExemptionResourceModel()
+	state := NewScorecardRuleExemptionResourceModel()
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entity, err := r.client.ScorecardRuleExemptions().Get(ctx, data.ScorecardTag.ValueString(), data.EntityTag.ValueString(), data.RuleIdentifier.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read scorecard rule exemption %s, got error: %s", state.Id.ValueString(), err))
+		return
+	}
+
+	if data.AutoApprove.ValueBool() && entity.Status == cortex.ScorecardRuleExemptionStatusPending {
+		entity, err = r.client.ScorecardRuleExemptions().Approve(ctx, data.ScorecardTag.ValueString(), data.EntityTag.ValueString(), data.RuleIdentifier.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to approve scorecard rule exemption, got error: %s", err))
+			return
+		}
+	}
+
+	data.FromApiModel(entity)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ScorecardRuleExemptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	data := NewScorecardRuleExemptionResourceModel()
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Expired exemptions no longer apply, so there is nothing to revoke.
+	if data.Status.ValueString() == cortex.ScorecardRuleExemptionStatusExpired {
+		return
+	}
+
+	err := r.client.ScorecardRuleExemptions().Revoke(ctx, data.ScorecardTag.ValueString(), data.EntityTag.ValueString(), cortex.RevokeScorecardRuleExemptionRequest{
+		RuleIdentifier: data.RuleIdentifier.ValueString(),
+		Reason:         scorecardRuleExemptionRevokeReason,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to revoke scorecard rule exemption, got error: %s", err))
+		return
+	}
+}
+
+func (r *ScorecardRuleExemptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.SplitN(req.ID, ":", 3)
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: scorecard_tag:entity_tag:rule_identifier. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("scorecard_tag"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("entity_tag"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("rule_identifier"), idParts[2])...)
+}
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScorecardRuleExemptionDaysRequiresReplace(t *testing.T) {
+	ctx := context.Background()
+	r := &ScorecardRuleExemptionResource{}
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	tests := []struct {
+		name            string
+		days            types.Int64
+		expiresAt       types.String
+		requiresReplace bool
+	}{
+		{name: "changed days", days: types.Int64Value(30), expiresAt: types.StringValue("2026-11-18T00:00:00Z"), requiresReplace: true},
+		{name: "imported with an expiry", days: types.Int64Null(), expiresAt: types.StringValue("2026-11-18T00:00:00Z"), requiresReplace: false},
+		{name: "added days to an exemption without an expiry", days: types.Int64Null(), expiresAt: types.StringNull(), requiresReplace: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			}
+			data := NewScorecardRuleExemptionResourceModel()
+			data.Id = types.StringValue("test-scorecard:test-service:has-oncall")
+			data.Days = tt.days
+			data.ExpiresAt = tt.expiresAt
+			assert.False(t, state.Set(ctx, &data).HasError())
+
+			req := planmodifier.Int64Request{
+				Path:       path.Root("days"),
+				State:      state,
+				StateValue: tt.days,
+				PlanValue:  types.Int64Value(7),
+			}
+			resp := &int64planmodifier.RequiresReplaceIfFuncResponse{}
+			scorecardRuleExemptionDaysRequiresReplace(ctx, req, resp)
+			assert.False(t, resp.Diagnostics.HasError())
+			assert.Equal(t, tt.requiresReplace, resp.RequiresReplace)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+/***********************************************************************************************************************
+ * Models
+ **********************************************************************************************************************/
+
+// ScorecardRuleExemptionResourceModel describes the scorecard rule exemption data model within Terraform.
+type ScorecardRuleExemptionResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	ScorecardTag   types.String `tfsdk:"scorecard_tag"`
+	EntityTag      types.String `tfsdk:"entity_tag"`
+	RuleIdentifier types.String `tfsdk:"rule_identifier"`
+	Reason         types.String `tfsdk:"reason"`
+	Days           types.Int64  `tfsdk:"days"`
+	AutoApprove    types.Bool   `tfsdk:"auto_approve"`
+	Status         types.String `tfsdk:"status"`
+	ExpiresAt      types.String `tfsdk:"expires_at"`
+}
+
+func (o *ScorecardRuleExemptionResourceModel) ToRequestApiModel() cortex.RequestScorecardRuleExemptionRequest {
+	return cortex.RequestScorecardRuleExemptionRequest{
+		RuleIdentifier: o.RuleIdentifier.ValueString(),
+		Reason:         o.Reason.ValueString(),
+		Days:           o.Days.ValueInt64(),
+	}
+}
+
+// FromApiModel maps the API exemption onto the model. Days and AutoApprove only affect how the exemption is requested,
+// so they are left as configured.
+func (o *ScorecardRuleExemptionResourceModel) FromApiModel(entity cortex.ScorecardRuleExemption) {
+	o.Id = types.StringValue(entity.ID())
+	o.ScorecardTag = types.StringValue(entity.ScorecardTag)
+	o.EntityTag = types.StringValue(entity.EntityTag)
+	o.RuleIdentifier = types.StringValue(entity.RuleIdentifier)
+	o.Reason = types.StringValue(entity.Reason)
+	o.Status = types.StringValue(entity.Status)
+	if entity.EndDate != "" {
+		o.ExpiresAt = types.StringValue(entity.EndDate)
+	} else {
+		o.ExpiresAt = types.StringNull()
+	}
+}
@@ -0,0 +1,69 @@
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+type testScorecardRuleExemptionResource struct {
+	ScorecardTag   string
+	EntityTag      string
+	RuleIdentifier string
+	Reason         string
+}
+
+func (t *testScorecardRuleExemptionResource) ResourceFullName() string {
+	return t.ResourceType() + ".test"
+}
+
+func (t *testScorecardRuleExemptionResource) ResourceType() string {
+	return "cortex_scorecard_rule_exemption"
+}
+
+func (t *testScorecardRuleExemptionResource) ToTerraform() string {
+	return fmt.Sprintf(`
+resource %[1]q "test" {
+  scorecard_tag   = %[2]q
+  entity_tag      = %[3]q
+  rule_identifier = %[4]q
+  reason          = %[5]q
+  days            = 30
+}`, t.ResourceType(), t.ScorecardTag, t.EntityTag, t.RuleIdentifier, t.Reason)
+}
+
+func TestAccScorecardRuleExemptionResource(t *testing.T) {
+	stub := testScorecardRuleExemptionResource{
+		ScorecardTag:   "onboarding-scorecard",
+		EntityTag:      "manual-test-service",
+		RuleIdentifier: "has-description",
+		Reason:         "Exempted by the Terraform acceptance tests",
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: stub.ToTerraform(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "id", stub.ScorecardTag+":"+stub.EntityTag+":"+stub.RuleIdentifier),
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "reason", stub.Reason),
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "auto_approve", "true"),
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "status", "APPROVED"),
+					resource.TestCheckResourceAttrSet(stub.ResourceFullName(), "expires_at"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            stub.ResourceFullName(),
+				ImportState:             true,
+				ImportStateId:           stub.ScorecardTag + ":" + stub.EntityTag + ":" + stub.RuleIdentifier,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"days"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
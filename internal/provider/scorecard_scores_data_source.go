@@ -0,0 +1,218 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ScorecardScoresDataSource{}
+
+func NewScorecardScoresDataSource() datasource.DataSource {
+	return &ScorecardScoresDataSource{}
+}
+
+// ScorecardScoresDataSource defines the data source implementation.
+type ScorecardScoresDataSource struct {
+	client *cortex.HttpClient
+}
+
+// ScorecardScoresDataSourceModel describes the data source data model.
+type ScorecardScoresDataSourceModel struct {
+	Id           types.String                                   `tfsdk:"id"`
+	ScorecardTag types.String                                   `tfsdk:"scorecard_tag"`
+	EntityTag    types.String                                   `tfsdk:"entity_tag"`
+	Level        types.String                                   `tfsdk:"level"`
+	Entities     map[string]ScorecardEntityScoreDataSourceModel `tfsdk:"entities"`
+}
+
+// ScorecardEntityScoreDataSourceModel is the latest score of a single entity, keyed by entity tag.
+type ScorecardEntityScoreDataSourceModel struct {
+	Name          types.String                        `tfsdk:"name"`
+	Level         types.String                        `tfsdk:"level"`
+	LevelRank     types.Int64                         `tfsdk:"level_rank"`
+	Score         types.Float64                       `tfsdk:"score"`
+	TotalScore    types.Float64                       `tfsdk:"total_possible_score"`
+	Percentage    types.Float64                       `tfsdk:"percentage"`
+	LastEvaluated types.String                        `tfsdk:"last_evaluated"`
+	Rules         []ScorecardRuleScoreDataSourceModel `tfsdk:"rules"`
+}
+
+type ScorecardRuleScoreDataSourceModel struct {
+	Title      types.String  `tfsdk:"title"`
+	Expression types.String  `tfsdk:"expression"`
+	Score      types.Float64 `tfsdk:"score"`
+	Passed     types.Bool    `tfsdk:"passed"`
+}
+
+func (o *ScorecardEntityScoreDataSourceModel) FromApiModel(entity cortex.ScorecardEntityScore) {
+	o.Name = types.StringValue(entity.Entity.Name)
+	if level := entity.Score.CurrentLevel(); level != nil {
+		o.Level = types.StringValue(level.Name)
+		o.LevelRank = types.Int64Value(level.Rank)
+	} else {
+		o.Level = types.StringNull()
+		o.LevelRank = types.Int64Value(0)
+	}
+	o.Score = types.Float64Value(entity.Score.Summary.Score)
+	o.TotalScore = types.Float64Value(entity.Score.Summary.TotalPossibleScore)
+	o.Percentage = types.Float64Value(entity.Score.Summary.Percentage())
+	o.LastEvaluated = types.StringValue(entity.LastEvaluated)
+
+	o.Rules = make([]ScorecardRuleScoreDataSourceModel, len(entity.Score.Rules))
+	for i, rule := range entity.Score.Rules {
+		o.Rules[i] = ScorecardRuleScoreDataSourceModel{
+			Title:      types.StringValue(rule.Title),
+			Expression: types.StringValue(rule.Expression),
+			Score:      types.Float64Value(rule.Score),
+			Passed:     types.BoolValue(rule.Passed()),
+		}
+	}
+}
+
+func (d *ScorecardScoresDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scorecard_scores"
+}
+
+func (d *ScorecardScoresDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Scorecard Scores data source - returns the latest evaluation of each entity against a scorecard",
+
+		Attributes: map[string]schema.Attribute{
+			// Required
+			"scorecard_tag": schema.StringAttribute{
+				MarkdownDescription: "Tag of the scorecard",
+				Required:            true,
+			},
+
+			// Optional
+			"entity_tag": schema.StringAttribute{
+				MarkdownDescription: "Only return the score of the entity with this tag",
+				Optional:            true,
+			},
+			"level": schema.StringAttribute{
+				MarkdownDescription: "Only return entities that are currently at this ladder level",
+				Optional:            true,
+			},
+
+			// Computed
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Internal identifier for this data source",
+				Computed:            true,
+			},
+			"entities": schema.MapNestedAttribute{
+				MarkdownDescription: "Scores keyed by entity tag",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Human-readable name of the entity",
+							Computed:            true,
+						},
+						"level": schema.StringAttribute{
+							MarkdownDescription: "Name of the ladder level the entity has achieved. Null if it has not achieved any level.",
+							Computed:            true,
+						},
+						"level_rank": schema.Int64Attribute{
+							MarkdownDescription: "Rank of the ladder level the entity has achieved, or 0 if it has not achieved any level. Useful for comparing against a minimum level.",
+							Computed:            true,
+						},
+						"score": schema.Float64Attribute{
+							MarkdownDescription: "Points the entity has been awarded",
+							Computed:            true,
+						},
+						"total_possible_score": schema.Float64Attribute{
+							MarkdownDescription: "Points available on the scorecard",
+							Computed:            true,
+						},
+						"percentage": schema.Float64Attribute{
+							MarkdownDescription: "Score as a percentage (0-100) of the total possible score",
+							Computed:            true,
+						},
+						"last_evaluated": schema.StringAttribute{
+							MarkdownDescription: "When the entity was last evaluated",
+							Computed:            true,
+						},
+						"rules": schema.ListNestedAttribute{
+							MarkdownDescription: "Results of the individual rules",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"title": schema.StringAttribute{
+										Computed: true,
+									},
+									"expression": schema.StringAttribute{
+										Computed: true,
+									},
+									"score": schema.Float64Attribute{
+										Computed: true,
+									},
+									"passed": schema.BoolAttribute{
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ScorecardScoresDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cortex.HttpClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ScorecardScoresDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ScorecardScoresDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	allScores, err := d.client.Scorecards().AllScores(ctx, data.ScorecardTag.ValueString(), data.EntityTag.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read scores for scorecard %s, got error: %s", data.ScorecardTag.ValueString(), err))
+		return
+	}
+
+	// Map response to state, dropping entities that aren't at the requested level
+	data.Entities = make(map[string]ScorecardEntityScoreDataSourceModel, len(allScores))
+	for _, score := range allScores {
+		item := ScorecardEntityScoreDataSourceModel{}
+		item.FromApiModel(score)
+		if !data.Level.IsNull() && item.Level.ValueString() != data.Level.ValueString() {
+			continue
+		}
+		data.Entities[score.Entity.Tag] = item
+	}
+
+	data.Id = types.StringValue(data.ScorecardTag.ValueString())
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
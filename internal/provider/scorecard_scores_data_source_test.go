@@ -0,0 +1,27 @@
+package provider_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccScorecardScoresDataSource(t *testing.T) {
+	recordName := "data.cortex_scorecard_scores.onboarding-scorecard"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `
+data "cortex_scorecard_scores" "onboarding-scorecard" {
+  scorecard_tag = "onboarding-scorecard"
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(recordName, "id", "onboarding-scorecard"),
+					resource.TestCheckResourceAttrSet(recordName, "entities.%"),
+				),
+			},
+		},
+	})
+}
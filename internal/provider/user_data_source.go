@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &UserDataSource{}
+
+func NewUserDataSource() datasource.DataSource {
+	return &UserDataSource{}
+}
+
+// UserDataSource defines the data source implementation.
+type UserDataSource struct {
+	client *cortex.HttpClient
+}
+
+// UserDataSourceModel describes the data source data model.
+type UserDataSourceModel struct {
+	Id    types.String   `tfsdk:"id"`
+	Email types.String   `tfsdk:"email"`
+	Name  types.String   `tfsdk:"name"`
+	Role  types.String   `tfsdk:"role"`
+	Teams []types.String `tfsdk:"teams"`
+}
+
+func (o *UserDataSourceModel) FromApiModel(user cortex.User) {
+	o.Id = types.StringValue(user.Id)
+	o.Email = types.StringValue(user.Email)
+	o.Name = types.StringValue(user.Name)
+	o.Role = types.StringValue(user.Role)
+	o.Teams = make([]types.String, len(user.Teams))
+	for i, team := range user.Teams {
+		o.Teams[i] = types.StringValue(team)
+	}
+}
+
+func (d *UserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (d *UserDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "User data source - looks up a Cortex user by email. Fails if there is no such user, for example because they have left the workspace.",
+
+		Attributes: map[string]schema.Attribute{
+			// Required
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email of the user",
+				Required:            true,
+			},
+
+			// Computed
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the user",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the user",
+				Computed:            true,
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "Tag of the user's role",
+				Computed:            true,
+			},
+			"teams": schema.ListAttribute{
+				MarkdownDescription: "Tags of the teams the user is a member of",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *UserDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cortex.HttpClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UserDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := d.client.Users().Get(ctx, data.Email.ValueString())
+	if err != nil {
+		if errors.Is(err, cortex.ApiErrorNotFound) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("email"),
+				"User Not Found",
+				fmt.Sprintf("No Cortex user has the email %q. They may have left the workspace.", data.Email.ValueString()),
+			)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user, got error: %s", err))
+		return
+	}
+
+	data.FromApiModel(user)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
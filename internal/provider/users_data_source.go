@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &UsersDataSource{}
+
+func NewUsersDataSource() datasource.DataSource {
+	return &UsersDataSource{}
+}
+
+// UsersDataSource defines the data source implementation.
+type UsersDataSource struct {
+	client *cortex.HttpClient
+}
+
+// UsersDataSourceModel describes the data source data model.
+type UsersDataSourceModel struct {
+	Id     types.String          `tfsdk:"id"`
+	Email  types.String          `tfsdk:"email"`
+	Name   types.String          `tfsdk:"name"`
+	Emails []types.String        `tfsdk:"emails"`
+	Users  []UserDataSourceModel `tfsdk:"users"`
+}
+
+func (d *UsersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (d *UsersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Users data source - searches the users of the Cortex workspace",
+
+		Attributes: map[string]schema.Attribute{
+			// Optional
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Only return users whose email contains this value, case-insensitively",
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Only return users whose name contains this value, case-insensitively",
+				Optional:            true,
+			},
+
+			// Computed
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Internal identifier for this data source",
+				Computed:            true,
+			},
+			"emails": schema.ListAttribute{
+				MarkdownDescription: "Emails of the matching users, for validating owner and team member emails",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"users": schema.ListNestedAttribute{
+				MarkdownDescription: "Matching users",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "ID of the user",
+							Computed:            true,
+						},
+						"email": schema.StringAttribute{
+							MarkdownDescription: "Email of the user",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the user",
+							Computed:            true,
+						},
+						"role": schema.StringAttribute{
+							MarkdownDescription: "Tag of the user's role",
+							Computed:            true,
+						},
+						"teams": schema.ListAttribute{
+							MarkdownDescription: "Tags of the teams the user is a member of",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *UsersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cortex.HttpClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UsersDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &cortex.UserListParams{
+		Email:    data.Email.ValueString(),
+		Name:     data.Name.ValueString(),
+		PageSize: 250,
+		Page:     0,
+	}
+
+	// Fetch all pages of results
+	data.Emails = []types.String{}
+	data.Users = []UserDataSourceModel{}
+	for {
+		usersResponse, err := d.client.Users().List(ctx, params)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read users, got error: %s", err))
+			return
+		}
+
+		for _, user := range usersResponse.Users {
+			item := UserDataSourceModel{}
+			item.FromApiModel(user)
+			data.Emails = append(data.Emails, item.Email)
+			data.Users = append(data.Users, item)
+		}
+
+		if usersResponse.Page >= usersResponse.TotalPages-1 || len(usersResponse.Users) == 0 {
+			break
+		}
+		params.Page++
+	}
+
+	data.Id = types.StringValue("users")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
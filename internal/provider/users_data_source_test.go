@@ -0,0 +1,52 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUsersDataSource(t *testing.T) {
+	recordName := "data.cortex_users.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing - list all users, and look the first one up by email
+			{
+				Config: testAccUsersDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(recordName, "id"),
+					resource.TestCheckResourceAttrSet(recordName, "users.#"),
+					resource.TestCheckResourceAttrPair("data.cortex_user.test", "email", recordName, "users.0.email"),
+					resource.TestCheckResourceAttrPair("data.cortex_user.test", "id", recordName, "users.0.id"),
+				),
+			},
+			// A user who doesn't exist fails the plan
+			{
+				Config:      testAccUserDataSourceMissingConfig(),
+				ExpectError: regexp.MustCompile("User Not Found"),
+			},
+		},
+	})
+}
+
+func testAccUsersDataSourceConfig() string {
+	return `
+data "cortex_users" "test" {
+}
+
+data "cortex_user" "test" {
+  email = data.cortex_users.test.emails[0]
+}
+`
+}
+
+func testAccUserDataSourceMissingConfig() string {
+	return `
+data "cortex_user" "missing" {
+  email = "terraform-provider-cortex-no-such-user@example.com"
+}
+`
+}
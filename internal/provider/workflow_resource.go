@@ -0,0 +1,390 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &WorkflowResource{}
+var _ resource.ResourceWithImportState = &WorkflowResource{}
+
+func NewWorkflowResource() resource.Resource {
+	return &WorkflowResource{}
+}
+
+/***********************************************************************************************************************
+ * Types
+ **********************************************************************************************************************/
+
+// WorkflowResource defines the resource implementation.
+type WorkflowResource struct {
+	client *cortex.HttpClient
+}
+
+/***********************************************************************************************************************
+ * Schema
+ **********************************************************************************************************************/
+
+func (r *WorkflowResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// Each action has exactly one type-specific block.
+	actionTypes := []path.Expression{
+		path.MatchRelative().AtParent().AtName("slack"),
+		path.MatchRelative().AtParent().AtName("manual_approval"),
+		path.MatchRelative().AtParent().AtName("scaffolder"),
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Workflow. Manages a Cortex automation workflow, either from typed `actions` or from a raw YAML `descriptor` for action types and settings the typed attributes don't cover.",
+
+		Attributes: map[string]schema.Attribute{
+			// Required attributes
+			"tag": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier for the workflow. Overrides any tag in `descriptor`. **Note:** Changing this attribute will force replacement of the resource.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Typed attributes
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the workflow. Required unless `descriptor` is set.",
+				Optional:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the workflow.",
+				Optional:            true,
+			},
+			"is_draft": schema.BoolAttribute{
+				MarkdownDescription: "Whether the workflow is a draft, which can't be run. Defaults to `false`. Ignored when `descriptor` is set.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"entity_types": schema.ListAttribute{
+				MarkdownDescription: "Entity types the workflow can be run against. If omitted, the workflow is global and isn't run against an entity.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"run_response_template": schema.StringAttribute{
+				MarkdownDescription: "Markdown shown to the user when the workflow finishes.",
+				Optional:            true,
+			},
+			"actions": schema.ListNestedAttribute{
+				MarkdownDescription: "Actions of the workflow. The workflow starts at the first action and continues with its `outgoing_actions`.",
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ExactlyOneOf(path.MatchRoot("descriptor")),
+					listvalidator.AlsoRequires(path.MatchRoot("name")),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the action.",
+							Required:            true,
+						},
+						"slug": schema.StringAttribute{
+							MarkdownDescription: "Identifier of the action, unique within the workflow. Referenced by `outgoing_actions` and by later actions' templates.",
+							Required:            true,
+						},
+						"outgoing_actions": schema.ListAttribute{
+							MarkdownDescription: "Slugs of the actions that run after this one.",
+							Optional:            true,
+							ElementType:         types.StringType,
+						},
+						"http_request": schema.SingleNestedAttribute{
+							MarkdownDescription: "Sends an HTTP request.",
+							Optional:            true,
+							Validators: []validator.Object{
+								objectvalidator.ExactlyOneOf(actionTypes...),
+							},
+							Attributes: map[string]schema.Attribute{
+								"method": schema.StringAttribute{
+									MarkdownDescription: "HTTP method: `GET`, `POST`, `PUT`, `PATCH` or `DELETE`.",
+									Required:            true,
+									Validators: []validator.String{
+										stringvalidator.OneOf("GET", "POST", "PUT", "PATCH", "DELETE"),
+									},
+								},
+								"url": schema.StringAttribute{
+									MarkdownDescription: "URL of the request.",
+									Required:            true,
+								},
+								"headers": schema.MapAttribute{
+									MarkdownDescription: "Headers of the request. Marked sensitive, as they usually include credentials such as an `Authorization` header.",
+									Optional:            true,
+									Sensitive:           true,
+									ElementType:         types.StringType,
+								},
+								"payload": schema.StringAttribute{
+									MarkdownDescription: "Body of the request.",
+									Optional:            true,
+								},
+							},
+						},
+						"slack": schema.SingleNestedAttribute{
+							MarkdownDescription: "Sends a Slack message.",
+							Optional:            true,
+							Attributes: map[string]schema.Attribute{
+								"channel": schema.StringAttribute{
+									MarkdownDescription: "Channel to send the message to.",
+									Required:            true,
+								},
+								"message": schema.StringAttribute{
+									MarkdownDescription: "Message to send.",
+									Required:            true,
+								},
+							},
+						},
+						"manual_approval": schema.SingleNestedAttribute{
+							MarkdownDescription: "Waits for a user to approve the run.",
+							Optional:            true,
+							Attributes: map[string]schema.Attribute{
+								"message": schema.StringAttribute{
+									MarkdownDescription: "Message shown to the approvers.",
+									Optional:            true,
+								},
+								"approver_emails": schema.ListAttribute{
+									MarkdownDescription: "Emails of the users who can approve the run.",
+									Required:            true,
+									ElementType:         types.StringType,
+									Validators: []validator.List{
+										listvalidator.SizeAtLeast(1),
+									},
+								},
+							},
+						},
+						"scaffolder": schema.SingleNestedAttribute{
+							MarkdownDescription: "Runs a scaffolder template.",
+							Optional:            true,
+							Attributes: map[string]schema.Attribute{
+								"template_tag": schema.StringAttribute{
+									MarkdownDescription: "Tag of the scaffolder template.",
+									Required:            true,
+								},
+								"inputs": schema.StringAttribute{
+									MarkdownDescription: "Inputs of the template, in JSON format in a string. (Use the `jsonencode` function to convert a JSON object to a string.)",
+									Optional:            true,
+								},
+							},
+						},
+					},
+				},
+			},
+
+			// Raw descriptor
+			"descriptor": schema.StringAttribute{
+				MarkdownDescription: "Workflow descriptor in YAML, as exported from Cortex. Use instead of `name`, `description`, `entity_types`, `run_response_template` and `actions` for action types and settings they don't cover.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("name"),
+						path.MatchRoot("description"),
+						path.MatchRoot("entity_types"),
+						path.MatchRoot("run_response_template"),
+					),
+				},
+			},
+
+			// Computed attributes
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+/***********************************************************************************************************************
+ * Methods
+ **********************************************************************************************************************/
+
+func (r *WorkflowResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow"
+}
+
+func (r *WorkflowResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cortex.HttpClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// read fetches the workflow into the model, as a raw descriptor if one is configured. Errors mapping the workflow onto
+// the model are added to diagnostics.
+func (r *WorkflowResource) read(ctx context.Context, diagnostics *diag.Diagnostics, data *WorkflowResourceModel) error {
+	if data.Descriptor.IsNull() {
+		workflow, err := r.client.Workflows().Get(ctx, data.Tag.ValueString())
+		if err != nil {
+			return err
+		}
+		data.FromApiModel(diagnostics, workflow)
+		return nil
+	}
+
+	descriptor, err := r.client.Workflows().GetDescriptor(ctx, data.Tag.ValueString())
+	if err != nil {
+		return err
+	}
+	data.Id = data.Tag
+	data.Descriptor, err = workflowDescriptorValue(data.Descriptor, data.Tag.ValueString(), descriptor)
+	return err
+}
+
+// upsert creates or updates the workflow from the model. Errors parsing the model are added to diagnostics.
+func (r *WorkflowResource) upsert(ctx context.Context, diagnostics *diag.Diagnostics, data *WorkflowResourceModel) error {
+	if data.Descriptor.IsNull() {
+		workflow := data.ToApiModel(diagnostics)
+		if diagnostics.HasError() {
+			return nil
+		}
+		_, err := r.client.Workflows().Upsert(ctx, workflow)
+		return err
+	}
+
+	_, body, err := workflowDescriptorBody(data.Tag.ValueString(), data.Descriptor.ValueString())
+	if err != nil {
+		return err
+	}
+	return r.client.Workflows().UpsertDescriptor(ctx, body)
+}
+
+func (r *WorkflowResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	data := NewWorkflowResourceModel()
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Issue API request
+	err := r.read(ctx, &resp.Diagnostics, &data)
+	if err != nil {
+		if errors.Is(err, cortex.ApiErrorNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workflow %s, got error: %s", data.Tag.ValueString(), err))
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WorkflowResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	data := NewWorkflowResourceModel()
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.upsert(ctx, &resp.Diagnostics, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create workflow, got error: %s", err))
+		return
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err = r.read(ctx, &resp.Diagnostics, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workflow after creating it, got error: %s", err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WorkflowResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	data := NewWorkflowResourceModel()
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.upsert(ctx, &resp.Diagnostics, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update workflow, got error: %s", err))
+		return
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err = r.read(ctx, &resp.Diagnostics, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workflow after updating it, got error: %s", err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WorkflowResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	data := NewWorkflowResourceModel()
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Workflows().Delete(ctx, data.Tag.ValueString())
+	if err != nil && !errors.Is(err, cortex.ApiErrorNotFound) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete workflow, got error: %s", err))
+		return
+	}
+}
+
+func (r *WorkflowResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("tag"), req, resp)
+}
@@ -0,0 +1,301 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
+)
+
+/***********************************************************************************************************************
+ * Models
+ **********************************************************************************************************************/
+
+// WorkflowResourceModel describes the workflow data model within Terraform.
+type WorkflowResourceModel struct {
+	Id                  types.String                  `tfsdk:"id"`
+	Tag                 types.String                  `tfsdk:"tag"`
+	Name                types.String                  `tfsdk:"name"`
+	Description         types.String                  `tfsdk:"description"`
+	IsDraft             types.Bool                    `tfsdk:"is_draft"`
+	EntityTypes         []types.String                `tfsdk:"entity_types"`
+	RunResponseTemplate types.String                  `tfsdk:"run_response_template"`
+	Actions             []WorkflowActionResourceModel `tfsdk:"actions"`
+	Descriptor          types.String                  `tfsdk:"descriptor"`
+}
+
+type WorkflowActionResourceModel struct {
+	Name            types.String                               `tfsdk:"name"`
+	Slug            types.String                               `tfsdk:"slug"`
+	OutgoingActions []types.String                             `tfsdk:"outgoing_actions"`
+	HttpRequest     *WorkflowHttpRequestActionResourceModel    `tfsdk:"http_request"`
+	Slack           *WorkflowSlackActionResourceModel          `tfsdk:"slack"`
+	ManualApproval  *WorkflowManualApprovalActionResourceModel `tfsdk:"manual_approval"`
+	Scaffolder      *WorkflowScaffolderActionResourceModel     `tfsdk:"scaffolder"`
+}
+
+type WorkflowHttpRequestActionResourceModel struct {
+	Method  types.String            `tfsdk:"method"`
+	Url     types.String            `tfsdk:"url"`
+	Headers map[string]types.String `tfsdk:"headers"`
+	Payload types.String            `tfsdk:"payload"`
+}
+
+type WorkflowSlackActionResourceModel struct {
+	Channel types.String `tfsdk:"channel"`
+	Message types.String `tfsdk:"message"`
+}
+
+type WorkflowManualApprovalActionResourceModel struct {
+	Message        types.String   `tfsdk:"message"`
+	ApproverEmails []types.String `tfsdk:"approver_emails"`
+}
+
+type WorkflowScaffolderActionResourceModel struct {
+	TemplateTag types.String `tfsdk:"template_tag"`
+	Inputs      types.String `tfsdk:"inputs"`
+}
+
+func NewWorkflowResourceModel() WorkflowResourceModel {
+	return WorkflowResourceModel{}
+}
+
+func (o *WorkflowResourceModel) ToApiModel(diagnostics *diag.Diagnostics) cortex.Workflow {
+	entity := cortex.Workflow{
+		Tag:                 o.Tag.ValueString(),
+		Name:                o.Name.ValueString(),
+		Description:         o.Description.ValueString(),
+		IsDraft:             o.IsDraft.ValueBool(),
+		Filter:              cortex.WorkflowFilter{Type: cortex.WorkflowFilterTypeGlobal},
+		RunResponseTemplate: o.RunResponseTemplate.ValueString(),
+		Actions:             make([]cortex.WorkflowAction, len(o.Actions)),
+	}
+	if len(o.EntityTypes) > 0 {
+		entity.Filter = cortex.WorkflowFilter{
+			Type:         cortex.WorkflowFilterTypeEntity,
+			EntityFilter: &cortex.WorkflowEntityFilter{EntityTypes: make([]string, len(o.EntityTypes))},
+		}
+		for i, t := range o.EntityTypes {
+			entity.Filter.EntityFilter.EntityTypes[i] = t.ValueString()
+		}
+	}
+	for i, action := range o.Actions {
+		entity.Actions[i] = action.ToApiModel(diagnostics)
+		// The workflow starts at the first action
+		entity.Actions[i].IsRootAction = i == 0
+	}
+	return entity
+}
+
+func (o *WorkflowResourceModel) FromApiModel(diagnostics *diag.Diagnostics, entity cortex.Workflow) {
+	o.Id = types.StringValue(entity.Tag)
+	o.Tag = types.StringValue(entity.Tag)
+	o.Name = types.StringValue(entity.Name)
+	o.Description = stringValueOrNull(entity.Description)
+	o.IsDraft = types.BoolValue(entity.IsDraft)
+	o.RunResponseTemplate = stringValueOrNull(entity.RunResponseTemplate)
+	o.EntityTypes = nil
+	if entity.Filter.EntityFilter != nil && len(entity.Filter.EntityFilter.EntityTypes) > 0 {
+		o.EntityTypes = make([]types.String, len(entity.Filter.EntityFilter.EntityTypes))
+		for i, t := range entity.Filter.EntityFilter.EntityTypes {
+			o.EntityTypes[i] = types.StringValue(t)
+		}
+	}
+	o.Actions = make([]WorkflowActionResourceModel, len(entity.Actions))
+	for i, action := range entity.Actions {
+		o.Actions[i].FromApiModel(diagnostics, action)
+	}
+}
+
+func (o *WorkflowActionResourceModel) ToApiModel(diagnostics *diag.Diagnostics) cortex.WorkflowAction {
+	action := cortex.WorkflowAction{
+		Name:            o.Name.ValueString(),
+		Slug:            o.Slug.ValueString(),
+		OutgoingActions: make([]string, len(o.OutgoingActions)),
+	}
+	for i, slug := range o.OutgoingActions {
+		action.OutgoingActions[i] = slug.ValueString()
+	}
+
+	switch {
+	case o.HttpRequest != nil:
+		action.Schema = map[string]interface{}{
+			"type":       cortex.WorkflowActionTypeHttpRequest,
+			"httpMethod": o.HttpRequest.Method.ValueString(),
+			"url":        o.HttpRequest.Url.ValueString(),
+		}
+		if len(o.HttpRequest.Headers) > 0 {
+			headers := map[string]interface{}{}
+			for k, v := range o.HttpRequest.Headers {
+				headers[k] = v.ValueString()
+			}
+			action.Schema["headers"] = headers
+		}
+		if !o.HttpRequest.Payload.IsNull() {
+			action.Schema["payload"] = o.HttpRequest.Payload.ValueString()
+		}
+	case o.Slack != nil:
+		action.Schema = map[string]interface{}{
+			"type":    cortex.WorkflowActionTypeSlack,
+			"channel": o.Slack.Channel.ValueString(),
+			"message": o.Slack.Message.ValueString(),
+		}
+	case o.ManualApproval != nil:
+		approvers := make([]interface{}, len(o.ManualApproval.ApproverEmails))
+		for i, email := range o.ManualApproval.ApproverEmails {
+			approvers[i] = map[string]interface{}{"type": "USER", "email": email.ValueString()}
+		}
+		action.Schema = map[string]interface{}{
+			"type":      cortex.WorkflowActionTypeManualApproval,
+			"approvers": approvers,
+		}
+		if !o.ManualApproval.Message.IsNull() {
+			action.Schema["message"] = o.ManualApproval.Message.ValueString()
+		}
+	case o.Scaffolder != nil:
+		action.Schema = map[string]interface{}{
+			"type":        cortex.WorkflowActionTypeScaffolder,
+			"templateTag": o.Scaffolder.TemplateTag.ValueString(),
+		}
+		if !o.Scaffolder.Inputs.IsNull() && !o.Scaffolder.Inputs.IsUnknown() && o.Scaffolder.Inputs.ValueString() != "" {
+			inputs := map[string]interface{}{}
+			err := json.Unmarshal([]byte(o.Scaffolder.Inputs.ValueString()), &inputs)
+			if err != nil {
+				diagnostics.AddError("error parsing scaffolder inputs", fmt.Sprintf("%+v", err))
+			}
+			action.Schema["inputs"] = inputs
+		}
+	}
+	return action
+}
+
+func (o *WorkflowActionResourceModel) FromApiModel(diagnostics *diag.Diagnostics, action cortex.WorkflowAction) {
+	o.Name = types.StringValue(action.Name)
+	o.Slug = types.StringValue(action.Slug)
+	o.OutgoingActions = nil
+	if len(action.OutgoingActions) > 0 {
+		o.OutgoingActions = make([]types.String, len(action.OutgoingActions))
+		for i, slug := range action.OutgoingActions {
+			o.OutgoingActions[i] = types.StringValue(slug)
+		}
+	}
+	o.HttpRequest = nil
+	o.Slack = nil
+	o.ManualApproval = nil
+	o.Scaffolder = nil
+
+	switch action.Type() {
+	case cortex.WorkflowActionTypeHttpRequest:
+		o.HttpRequest = &WorkflowHttpRequestActionResourceModel{
+			Method:  types.StringValue(cortex.MapFetchToString(action.Schema, "httpMethod")),
+			Url:     types.StringValue(cortex.MapFetchToString(action.Schema, "url")),
+			Payload: stringValueOrNull(cortex.MapFetchToString(action.Schema, "payload")),
+		}
+		if headers, ok := action.Schema["headers"].(map[string]interface{}); ok && len(headers) > 0 {
+			o.HttpRequest.Headers = map[string]types.String{}
+			for k, v := range headers {
+				o.HttpRequest.Headers[k] = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	case cortex.WorkflowActionTypeSlack:
+		o.Slack = &WorkflowSlackActionResourceModel{
+			Channel: types.StringValue(cortex.MapFetchToString(action.Schema, "channel")),
+			Message: types.StringValue(cortex.MapFetchToString(action.Schema, "message")),
+		}
+	case cortex.WorkflowActionTypeManualApproval:
+		o.ManualApproval = &WorkflowManualApprovalActionResourceModel{
+			Message: stringValueOrNull(cortex.MapFetchToString(action.Schema, "message")),
+		}
+		if approvers, ok := action.Schema["approvers"].([]interface{}); ok {
+			for _, approver := range approvers {
+				if approverMap, ok := approver.(map[string]interface{}); ok {
+					o.ManualApproval.ApproverEmails = append(o.ManualApproval.ApproverEmails, types.StringValue(cortex.MapFetchToString(approverMap, "email")))
+				}
+			}
+		}
+	case cortex.WorkflowActionTypeScaffolder:
+		o.Scaffolder = &WorkflowScaffolderActionResourceModel{
+			TemplateTag: types.StringValue(cortex.MapFetchToString(action.Schema, "templateTag")),
+			Inputs:      types.StringNull(),
+		}
+		if inputs, ok := action.Schema["inputs"].(map[string]interface{}); ok {
+			o.Scaffolder.Inputs = customDataValue(diagnostics, inputs)
+		}
+	default:
+		diagnostics.AddError(
+			"Unsupported workflow action",
+			fmt.Sprintf("Action %q has type %s, which can only be managed with the descriptor attribute.", action.Slug, action.Type()),
+		)
+	}
+}
+
+/***********************************************************************************************************************
+ * Descriptors
+ **********************************************************************************************************************/
+
+// workflowDescriptorBody parses a raw YAML descriptor and sets its tag, returning the descriptor to upsert.
+func workflowDescriptorBody(tag string, descriptor string) (map[string]interface{}, string, error) {
+	body := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(descriptor), &body); err != nil {
+		return nil, "", fmt.Errorf("could not parse descriptor: %w", err)
+	}
+	if body == nil {
+		body = map[string]interface{}{}
+	}
+	body["tag"] = tag
+	bytes, err := yaml.Marshal(body)
+	if err != nil {
+		return nil, "", fmt.Errorf("could not marshal descriptor: %w", err)
+	}
+	return body, string(bytes), nil
+}
+
+// workflowDescriptorValue keeps the configured descriptor if the API's descriptor contains everything in it; the API
+// adds fields with default values, which would otherwise show as changes on every plan.
+func workflowDescriptorValue(configured types.String, tag string, actual map[string]interface{}) (types.String, error) {
+	if !configured.IsNull() && !configured.IsUnknown() {
+		expected, _, err := workflowDescriptorBody(tag, configured.ValueString())
+		if err == nil && yamlContains(expected, actual) {
+			return configured, nil
+		}
+	}
+	bytes, err := yaml.Marshal(actual)
+	if err != nil {
+		return types.StringNull(), fmt.Errorf("could not marshal descriptor: %w", err)
+	}
+	return types.StringValue(string(bytes)), nil
+}
+
+// yamlContains reports whether every value set in expected is also set in actual.
+func yamlContains(expected interface{}, actual interface{}) bool {
+	switch e := expected.(type) {
+	case map[string]interface{}:
+		a, ok := actual.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, v := range e {
+			if !yamlContains(v, a[k]) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		a, ok := actual.([]interface{})
+		if !ok || len(a) != len(e) {
+			return false
+		}
+		for i := range e {
+			if !yamlContains(e[i], a[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(expected, actual)
+	}
+}
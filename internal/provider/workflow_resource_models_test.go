@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkflowResourceModelRoundTrip(t *testing.T) {
+	model := WorkflowResourceModel{
+		Tag:         types.StringValue("provision-database"),
+		Name:        types.StringValue("Provision database"),
+		IsDraft:     types.BoolValue(false),
+		EntityTypes: []types.String{types.StringValue("service")},
+		Actions: []WorkflowActionResourceModel{
+			{
+				Name:            types.StringValue("Approve"),
+				Slug:            types.StringValue("approve"),
+				OutgoingActions: []types.String{types.StringValue("provision")},
+				ManualApproval: &WorkflowManualApprovalActionResourceModel{
+					Message:        types.StringNull(),
+					ApproverEmails: []types.String{types.StringValue("dba@example.com")},
+				},
+			},
+			{
+				Name: types.StringValue("Provision"),
+				Slug: types.StringValue("provision"),
+				HttpRequest: &WorkflowHttpRequestActionResourceModel{
+					Method:  types.StringValue("POST"),
+					Url:     types.StringValue("https://infra.example.com/databases"),
+					Headers: map[string]types.String{"Content-Type": types.StringValue("application/json")},
+					Payload: types.StringValue(`{"entity":"{{context.entity.tag}}"}`),
+				},
+			},
+		},
+	}
+
+	diagnostics := diag.Diagnostics{}
+	workflow := model.ToApiModel(&diagnostics)
+	assert.False(t, diagnostics.HasError())
+	assert.Equal(t, cortex.WorkflowFilterTypeEntity, workflow.Filter.Type)
+	assert.True(t, workflow.Actions[0].IsRootAction)
+	assert.False(t, workflow.Actions[1].IsRootAction)
+	assert.Equal(t, cortex.WorkflowActionTypeManualApproval, workflow.Actions[0].Type())
+	assert.Equal(t, "POST", workflow.Actions[1].Schema["httpMethod"])
+
+	result := NewWorkflowResourceModel()
+	result.FromApiModel(&diagnostics, workflow)
+	assert.False(t, diagnostics.HasError())
+	assert.Equal(t, model.Actions, result.Actions)
+	assert.Equal(t, model.EntityTypes, result.EntityTypes)
+	assert.Equal(t, types.StringNull(), result.Description)
+}
+
+func TestWorkflowActionResourceModelUnsupportedType(t *testing.T) {
+	diagnostics := diag.Diagnostics{}
+	action := WorkflowActionResourceModel{}
+	action.FromApiModel(&diagnostics, cortex.WorkflowAction{
+		Name:   "Run script",
+		Slug:   "run-script",
+		Schema: map[string]interface{}{"type": "JAVASCRIPT"},
+	})
+	assert.True(t, diagnostics.HasError())
+}
+
+func TestWorkflowDescriptorValue(t *testing.T) {
+	configured := types.StringValue("name: Restart service\nactions:\n  - slug: restart\n    schema:\n      type: JAVASCRIPT\n")
+	actual := map[string]interface{}{
+		"tag":     "restart-service",
+		"name":    "Restart service",
+		"isDraft": false,
+		"actions": []interface{}{
+			map[string]interface{}{"slug": "restart", "schema": map[string]interface{}{"type": "JAVASCRIPT", "timeout": 30}},
+		},
+	}
+
+	value, err := workflowDescriptorValue(configured, "restart-service", actual)
+	assert.Nil(t, err)
+	assert.Equal(t, configured, value, "fields only set by the API are ignored")
+
+	actual["name"] = "Restart service (edited)"
+	value, err = workflowDescriptorValue(configured, "restart-service", actual)
+	assert.Nil(t, err)
+	assert.NotEqual(t, configured, value, "changed fields are reported")
+	assert.Contains(t, value.ValueString(), "Restart service (edited)")
+}
@@ -0,0 +1,109 @@
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+type testWorkflowResource struct {
+	Tag  string
+	Name string
+}
+
+func (t *testWorkflowResource) ResourceFullName() string {
+	return t.ResourceType() + ".test"
+}
+
+func (t *testWorkflowResource) ResourceType() string {
+	return "cortex_workflow"
+}
+
+func (t *testWorkflowResource) ToTerraform() string {
+	return fmt.Sprintf(`
+resource %[1]q "test" {
+  tag          = %[2]q
+  name         = %[3]q
+  is_draft     = true
+  entity_types = ["service"]
+  actions = [
+    {
+      name             = "Approve"
+      slug             = "approve"
+      outgoing_actions = ["notify"]
+      manual_approval = {
+        approver_emails = ["terraform-acceptance@cortex.io"]
+      }
+    },
+    {
+      name = "Notify"
+      slug = "notify"
+      http_request = {
+        method  = "POST"
+        url     = "https://example.com/hooks/terraform-acceptance"
+        payload = jsonencode({ "entity" : "{{context.entity.tag}}" })
+      }
+    }
+  ]
+}`, t.ResourceType(), t.Tag, t.Name)
+}
+
+func (t *testWorkflowResource) ToTerraformDescriptor() string {
+	return fmt.Sprintf(`
+resource %[1]q "test" {
+  tag        = %[2]q
+  descriptor = <<-EOT
+    name: %[3]s
+    isDraft: true
+    filter:
+      type: GLOBAL
+    actions:
+      - name: Notify
+        slug: notify
+        isRootAction: true
+        outgoingActions: []
+        schema:
+          type: HTTP_REQUEST
+          httpMethod: GET
+          url: https://example.com/hooks/terraform-acceptance
+  EOT
+}`, t.ResourceType(), t.Tag, t.Name)
+}
+
+func TestAccWorkflowResource(t *testing.T) {
+	stub := testWorkflowResource{
+		Tag:  "terraform-acceptance-workflow",
+		Name: "Terraform acceptance workflow",
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: stub.ToTerraform(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "id", stub.Tag),
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "actions.#", "2"),
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "actions.0.manual_approval.approver_emails.0", "terraform-acceptance@cortex.io"),
+					resource.TestCheckResourceAttr(stub.ResourceFullName(), "actions.1.http_request.method", "POST"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      stub.ResourceFullName(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update to a raw descriptor and Read testing
+			{
+				Config: stub.ToTerraformDescriptor(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(stub.ResourceFullName(), "descriptor"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}